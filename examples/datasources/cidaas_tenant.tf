@@ -0,0 +1 @@
+data "cidaas_tenant" "example" {}
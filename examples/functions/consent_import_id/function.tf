@@ -0,0 +1,4 @@
+import {
+  to = cidaas_consent.sample
+  id = provider::cidaas::consent_import_id(cidaas_consent_group.sample.id, "sample_consent")
+}
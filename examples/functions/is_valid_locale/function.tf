@@ -0,0 +1,9 @@
+variable "default_locale" {
+  type    = string
+  default = "en-US"
+
+  validation {
+    condition     = provider::cidaas::is_valid_locale(var.default_locale)
+    error_message = "The default_locale must be a locale supported by cidaas."
+  }
+}
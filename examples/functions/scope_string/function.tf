@@ -0,0 +1,3 @@
+output "scope" {
+  value = provider::cidaas::scope_string(tolist(cidaas_app.sample.allowed_scopes))
+}
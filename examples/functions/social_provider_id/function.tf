@@ -0,0 +1,4 @@
+import {
+  to = cidaas_social_provider.google
+  id = provider::cidaas::social_provider_id("google", "8d789b3d-b312-4c44-ae5e")
+}
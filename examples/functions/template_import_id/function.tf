@@ -0,0 +1,4 @@
+import {
+  to = cidaas_template.verify_user
+  id = provider::cidaas::template_import_id("VERIFY_USER", "EMAIL", "de-de", null)
+}
@@ -0,0 +1,19 @@
+locals {
+  preview = provider::cidaas::template_preview(
+    "Hi {{name}}, please verify your account with the link {{{verify_link}}}",
+    "Welcome to {{account_name}}",
+    {
+      name         = "John"
+      verify_link  = "https://example.com/verify"
+      account_name = "cidaas"
+    }
+  )
+}
+
+output "rendered_content" {
+  value = local.preview.content
+}
+
+output "unresolved_placeholders" {
+  value = local.preview.unresolved_placeholders
+}
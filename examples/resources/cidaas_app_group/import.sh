@@ -0,0 +1 @@
+terraform import cidaas_app_group.resource_name client_group_id
\ No newline at end of file
@@ -0,0 +1,7 @@
+resource "cidaas_app_group" "sample" {
+  client_group_id   = "partner-apps"
+  group_name        = "Partner Apps"
+  description       = "Apps operated by our partners"
+  template_group_id = "partner"
+  hosted_page_group = "partner"
+}
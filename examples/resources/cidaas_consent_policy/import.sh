@@ -0,0 +1 @@
+terraform import cidaas_consent_policy.resource_name consent_group_id:consent_name:en:de
\ No newline at end of file
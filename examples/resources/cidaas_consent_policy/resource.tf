@@ -0,0 +1,22 @@
+resource "cidaas_consent_group" "sample" {
+  group_name  = "sample_consent_group"
+  description = "sample description"
+}
+
+# every change of consent_type, scopes, required_fields or locales creates a new consent version
+resource "cidaas_consent_policy" "sample" {
+  consent_group_id = cidaas_consent_group.sample.id
+  name             = "sample_consent"
+  enabled          = true
+  consent_type     = "SCOPES"
+  scopes           = ["developer"]
+  required_fields  = ["name"]
+  locales = {
+    en = {
+      content = "consent in English"
+    }
+    de = {
+      content = "consent in German"
+    }
+  }
+}
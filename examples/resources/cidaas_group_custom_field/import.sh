@@ -0,0 +1 @@
+terraform import cidaas_group_custom_field.resource_name field_key
\ No newline at end of file
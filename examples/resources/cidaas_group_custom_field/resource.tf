@@ -0,0 +1,19 @@
+# The field cost_center must be set on every user group of the group type 'department'.
+# Group custom fields without group_types apply to user groups of any group type.
+
+resource "cidaas_group_custom_field" "sample" {
+  field_key   = "cost_center"
+  data_type   = "TEXT"
+  required    = true
+  group_types = ["department"]
+}
+
+resource "cidaas_user_group" "sample" {
+  group_type = "department"
+  group_id   = "sample-group-id"
+  group_name = "sample-group-name"
+  custom_fields = {
+    cost_center = "CC-1234"
+  }
+  depends_on = [cidaas_group_custom_field.sample]
+}
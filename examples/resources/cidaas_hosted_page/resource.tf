@@ -16,3 +16,24 @@ resource "cidaas_hosted_page" "sample" {
     }
   ]
 }
+
+# the content of the hosted pages can be uploaded from local files instead of inline content
+resource "cidaas_hosted_page" "from_files" {
+  hosted_page_group_name = "terraform-sample-hosted-page-files"
+  default_locale         = "en-US"
+  hosted_pages = [
+    {
+      hosted_page_id = "login"
+      locale         = "en-US"
+      url            = "https://cidaas.de/login"
+      source_file    = "${path.module}/pages/login.html"
+    },
+    {
+      # reads the file ${path.module}/pages/de-DE/login.html
+      hosted_page_id = "login"
+      locale         = "de-DE"
+      url            = "https://cidaas.de/de/login"
+      source_dir     = "${path.module}/pages/de-DE"
+    }
+  ]
+}
@@ -0,0 +1 @@
+terraform import cidaas_registration_field_order.resource_name parent_group_id
\ No newline at end of file
@@ -0,0 +1,14 @@
+# orders the fields of the DEFAULT group, fields not listed are placed behind the listed ones
+resource "cidaas_registration_field_order" "default" {
+  field_keys = [
+    "given_name",
+    "family_name",
+    cidaas_registration_field.text.field_key,
+  ]
+}
+
+# orders the fields of a registration group
+resource "cidaas_registration_field_order" "address" {
+  parent_group_id = "address"
+  field_keys      = ["street", "zip_code", "city"]
+}
@@ -0,0 +1 @@
+terraform import cidaas_role_members.resource_name role
\ No newline at end of file
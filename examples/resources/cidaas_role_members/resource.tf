@@ -0,0 +1,7 @@
+resource "cidaas_role_members" "sample" {
+  role = cidaas_role.sample.role
+  subs = [
+    cidaas_user.sample.sub,
+    "7bc5d2f4-6b5a-4b8f-9c1e-2f3a4b5c6d7e",
+  ]
+}
@@ -3,21 +3,18 @@ resource "cidaas_scope" "sample" {
   scope_key             = "terraform-sample-scope"
   required_user_consent = false
   group_name            = []
-  localized_descriptions = [
-    {
+  localized_descriptions = {
+    "ar-TN" = {
       title       = "Cidaas Scope Tunisia Title"
-      locale      = "ar-TN"
       description = "This is scope in local ar-TN"
-    },
-    {
+    }
+    "de-DE" = {
       title       = "Cidaas Scope German Title"
-      locale      = "de-DE"
       description = "This is scope in local de-DE"
-    },
-    {
+    }
+    "en-IN" = {
       title       = "Cidaas Scope India Title"
-      locale      = "en-IN"
       description = "This is scope in local en-IN"
     }
-  ]
+  }
 }
@@ -0,0 +1 @@
+terraform import cidaas_template_set.resource_name template_key:template_type
\ No newline at end of file
@@ -0,0 +1,14 @@
+resource "cidaas_template_set" "welcome_email" {
+  template_key  = "WELCOME_EMAIL"
+  template_type = "EMAIL"
+  locales = {
+    "en-us" = {
+      subject = "Welcome to cidaas"
+      content = "Hi {{name}}, welcome to cidaas."
+    }
+    "de-de" = {
+      subject = "Willkommen bei cidaas"
+      content = "Hallo {{name}}, willkommen bei cidaas."
+    }
+  }
+}
@@ -0,0 +1 @@
+terraform import cidaas_tenant_settings.resource_name tenant
\ No newline at end of file
@@ -0,0 +1,7 @@
+resource "cidaas_tenant_settings" "sample" {
+  tenant_name     = "Sample Tenant"
+  default_locale  = "en-US"
+  company_name    = "Widas ID GmbH"
+  company_address = "Maybachstraße 2, 71299 Wimsheim, Germany"
+  company_website = "https://www.cidaas.com"
+}
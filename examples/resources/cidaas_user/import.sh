@@ -0,0 +1 @@
+terraform import cidaas_user.resource_name sub
\ No newline at end of file
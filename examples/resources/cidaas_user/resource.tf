@@ -0,0 +1,11 @@
+resource "cidaas_user" "sample" {
+  email            = "service-account@example.com"
+  mobile_number    = "+4915112345678"
+  given_name       = "Service"
+  family_name      = "Account"
+  initial_password = var.initial_password // write-only, never stored in the state
+  status           = "VERIFIED"
+  custom_fields = {
+    department = "IT"
+  }
+}
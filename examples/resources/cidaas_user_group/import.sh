@@ -0,0 +1 @@
+terraform import cidaas_user_group.resource_name group_id
\ No newline at end of file
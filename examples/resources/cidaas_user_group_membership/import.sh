@@ -0,0 +1 @@
+terraform import cidaas_user_group_membership.resource_name group_id:sub
\ No newline at end of file
@@ -0,0 +1,5 @@
+resource "cidaas_user_group_membership" "sample" {
+  group_id = cidaas_user_group.sample.group_id
+  sub      = cidaas_user.sample.sub
+  roles    = ["ADMIN"]
+}
@@ -1 +0,0 @@
-terraform import cidaas_user_groups.resource_name group_id
\ No newline at end of file
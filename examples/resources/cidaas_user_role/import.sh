@@ -0,0 +1 @@
+terraform import cidaas_user_role.resource_name role:sub
\ No newline at end of file
@@ -0,0 +1,4 @@
+resource "cidaas_user_role" "sample" {
+  role = cidaas_role.sample.role
+  sub  = cidaas_user.sample.sub
+}
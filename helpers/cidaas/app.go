@@ -14,6 +14,12 @@ type AppResponse struct {
 	Data    AppModel `json:"data,omitempty"`
 }
 
+type AllAppResponse struct {
+	Success bool       `json:"success,omitempty"`
+	Status  int64      `json:"status,omitempty"`
+	Data    []AppModel `json:"data,omitempty"`
+}
+
 type AppModel struct {
 	ID                               string                      `json:"_id,omitempty"`
 	ClientType                       string                      `json:"client_type,omitempty"`
@@ -136,10 +142,10 @@ type AppModel struct {
 	EnableLoginSpi                   *bool                       `json:"enable_login_spi,omitempty"`
 	AcceptRolesInTheRegistration     *bool                       `json:"accept_roles_in_the_registration,omitempty"`
 	OauthStandard                    string                      `json:"oauthStandard,omitempty"`
+	ClientGroupID                    string                      `json:"client_group_id,omitempty"`
 
 	// attributes not available in resource app schema
 	TappID          string         `json:"tapp_id,omitempty"`
-	ClientGroupID   string         `json:"client_group_id,omitempty"`
 	LegalEntity     string         `json:"legal_entity,omitempty"`
 	Tenant          string         `json:"tenant,omitempty"`
 	DeviceCode      *bool          `json:"device_code,omitempty"`
@@ -339,3 +345,18 @@ func (a *App) Delete(ctx context.Context, clientID string) error {
 	defer res.Body.Close()
 	return nil
 }
+
+// GetAll returns all apps of the tenant.
+func (a *App) GetAll(ctx context.Context) ([]AppModel, error) {
+	res, err := a.makeRequest(ctx, http.MethodPost, "apps-srv/clients/list", struct{}{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get all apps: %w", err)
+	}
+	defer res.Body.Close()
+
+	var response AllAppResponse
+	if err = util.ProcessResponse(res, &response); err != nil {
+		return nil, err
+	}
+	return response.Data, nil
+}
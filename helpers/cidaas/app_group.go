@@ -0,0 +1,95 @@
+package cidaas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
+)
+
+type AppGroupModel struct {
+	ID              string `json:"_id,omitempty"`
+	ClientGroupID   string `json:"client_group_id,omitempty"`
+	GroupName       string `json:"group_name,omitempty"`
+	Description     string `json:"description,omitempty"`
+	TemplateGroupID string `json:"template_group_id,omitempty"`
+	HostedPageGroup string `json:"hosted_page_group,omitempty"`
+	CreatedTime     string `json:"createdTime,omitempty"`
+	UpdatedTime     string `json:"updatedTime,omitempty"`
+}
+
+type AppGroupResponse struct {
+	Success bool          `json:"success,omitempty"`
+	Status  int           `json:"status,omitempty"`
+	Data    AppGroupModel `json:"data,omitempty"`
+}
+
+type AppGroup struct {
+	ClientConfig
+}
+
+func NewAppGroup(clientConfig ClientConfig) *AppGroup {
+	return &AppGroup{clientConfig}
+}
+
+const appGroupEndpoint = "apps-srv/clientgroups"
+
+func (a *AppGroup) Create(ctx context.Context, group AppGroupModel) (*AppGroupResponse, error) {
+	res, err := a.makeRequest(ctx, http.MethodPost, appGroupEndpoint, group)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create app group: %w", err)
+	}
+	defer res.Body.Close()
+
+	var response AppGroupResponse
+	if err = util.ProcessResponse(res, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (a *AppGroup) Get(ctx context.Context, clientGroupID string) (*AppGroupResponse, error) {
+	if clientGroupID == "" {
+		return nil, fmt.Errorf("clientGroupID cannot be empty")
+	}
+	endpoint := fmt.Sprintf("%s/%s", appGroupEndpoint, clientGroupID)
+	res, err := a.makeRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get app group: %w", err)
+	}
+	defer res.Body.Close()
+
+	var response AppGroupResponse
+	if err = util.ProcessResponse(res, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (a *AppGroup) Update(ctx context.Context, group AppGroupModel) (*AppGroupResponse, error) {
+	res, err := a.makeRequest(ctx, http.MethodPut, appGroupEndpoint, group)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update app group: %w", err)
+	}
+	defer res.Body.Close()
+
+	var response AppGroupResponse
+	if err = util.ProcessResponse(res, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (a *AppGroup) Delete(ctx context.Context, clientGroupID string) error {
+	if clientGroupID == "" {
+		return fmt.Errorf("clientGroupID cannot be empty")
+	}
+	endpoint := fmt.Sprintf("%s/%s", appGroupEndpoint, clientGroupID)
+	res, err := a.makeRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete app group: %w", err)
+	}
+	defer res.Body.Close()
+	return nil
+}
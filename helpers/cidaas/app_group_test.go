@@ -0,0 +1,154 @@
+// helpers/cidaas/app_group_test.go
+package cidaas
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNewAppGroup(t *testing.T) {
+	config := NewTestClientConfig("http://test.com")
+
+	appGroup := NewAppGroup(config)
+
+	if appGroup == nil {
+		t.Fatal("Expected app group instance, got nil")
+	}
+
+	if appGroup.BaseURL != config.BaseURL {
+		t.Errorf("Expected BaseURL %s, got %s", config.BaseURL, appGroup.BaseURL)
+	}
+}
+
+func TestAppGroup_Create_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST method, got %s", r.Method)
+		}
+
+		if !strings.HasSuffix(r.URL.Path, "apps-srv/clientgroups") {
+			t.Errorf("Expected apps-srv/clientgroups endpoint, got %s", r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var received AppGroupModel
+		json.Unmarshal(body, &received)
+
+		if received.ClientGroupID != "partner-apps" {
+			t.Errorf("Expected ClientGroupID partner-apps, got %s", received.ClientGroupID)
+		}
+
+		received.ID = "group-123"
+		response := AppGroupResponse{
+			Success: true,
+			Status:  200,
+			Data:    received,
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	appGroup := NewAppGroup(NewTestClientConfig(server.URL))
+
+	result, err := appGroup.Create(context.Background(), AppGroupModel{ClientGroupID: "partner-apps", GroupName: "Partner Apps"})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	if result.Data.ID != "group-123" {
+		t.Errorf("Expected ID group-123, got %s", result.Data.ID)
+	}
+}
+
+func TestAppGroup_Get_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET method, got %s", r.Method)
+		}
+
+		if !strings.HasSuffix(r.URL.Path, "apps-srv/clientgroups/partner-apps") {
+			t.Errorf("Expected client group id in URL path, got %s", r.URL.Path)
+		}
+
+		response := AppGroupResponse{
+			Success: true,
+			Status:  200,
+			Data: AppGroupModel{
+				ClientGroupID: "partner-apps",
+				GroupName:     "Partner Apps",
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	appGroup := NewAppGroup(NewTestClientConfig(server.URL))
+
+	result, err := appGroup.Get(context.Background(), "partner-apps")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+
+	if result.Data.GroupName != "Partner Apps" {
+		t.Errorf("Expected GroupName Partner Apps, got %s", result.Data.GroupName)
+	}
+}
+
+func TestAppGroup_Get_EmptyID(t *testing.T) {
+	appGroup := NewAppGroup(ClientConfig{})
+
+	_, err := appGroup.Get(context.Background(), "")
+	if err == nil {
+		t.Fatal("Expected error for empty client group id, got nil")
+	}
+
+	if err.Error() != "clientGroupID cannot be empty" {
+		t.Errorf("Expected 'clientGroupID cannot be empty', got %s", err.Error())
+	}
+}
+
+func TestAppGroup_Update_ServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error": "invalid group"}`))
+	}))
+	defer server.Close()
+
+	appGroup := NewAppGroup(NewTestClientConfig(server.URL))
+
+	_, err := appGroup.Update(context.Background(), AppGroupModel{ClientGroupID: "partner-apps"})
+	if err == nil {
+		t.Fatal("Expected error for server error, got nil")
+	}
+
+	if !strings.Contains(err.Error(), "failed to update app group") {
+		t.Errorf("Expected 'failed to update app group' in error, got %s", err.Error())
+	}
+}
+
+func TestAppGroup_Delete_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE method, got %s", r.Method)
+		}
+
+		if !strings.HasSuffix(r.URL.Path, "apps-srv/clientgroups/partner-apps") {
+			t.Errorf("Expected client group id in URL path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	appGroup := NewAppGroup(NewTestClientConfig(server.URL))
+
+	if err := appGroup.Delete(context.Background(), "partner-apps"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+}
@@ -1073,3 +1073,64 @@ func TestApp_Delete_MultipleClients(t *testing.T) {
 		}
 	}
 }
+
+func TestApp_GetAll_Success(t *testing.T) {
+	expectedApps := []AppModel{
+		{ClientID: "client-1", ClientName: "First App", RequiredFields: []string{"email"}},
+		{ClientID: "client-2", ClientName: "Second App", AllowedFields: []string{"given_name"}},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST method, got %s", r.Method)
+		}
+
+		if !strings.Contains(r.URL.Path, "apps-srv/clients/list") {
+			t.Errorf("Expected apps-srv/clients/list endpoint, got %s", r.URL.Path)
+		}
+
+		response := AllAppResponse{
+			Success: true,
+			Status:  200,
+			Data:    expectedApps,
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	config := NewTestClientConfig(server.URL)
+	app := NewApp(config)
+
+	result, err := app.GetAll(context.Background())
+
+	if err != nil {
+		t.Fatalf("GetAll failed: %v", err)
+	}
+
+	if len(result) != 2 {
+		t.Fatalf("Expected 2 apps, got %d", len(result))
+	}
+
+	if result[0].RequiredFields[0] != "email" {
+		t.Errorf("Expected required field 'email', got %v", result[0].RequiredFields)
+	}
+
+	if result[1].ClientName != "Second App" {
+		t.Errorf("Expected ClientName 'Second App', got %s", result[1].ClientName)
+	}
+}
+
+func TestApp_GetAll_Error(t *testing.T) {
+	server := NewMockServer(http.StatusInternalServerError, `{"error": "server error"}`)
+	defer server.Close()
+
+	config := NewTestClientConfig(server.URL)
+	app := NewApp(config)
+
+	_, err := app.GetAll(context.Background())
+
+	if err == nil {
+		t.Error("Expected error for server error, got nil")
+	}
+}
@@ -19,15 +19,19 @@ type Client struct {
 	ConsentGroup   *ConsentGroup
 	GroupType      *GroupType
 	UserGroup      *UserGroup
+	GroupFields    *GroupCustomField
 	HostedPages    *HostedPage
 	Webhook        *Webhook
 	Apps           *App
+	AppGroups      *AppGroup
 	RegFields      *RegField
 	TemplateGroup  *TemplateGroup
 	Templates      *Template
 	PasswordPolicy *PasswordPolicy
 	Consent        *Consent
 	ConsentVersion *ConsentVersion
+	Tenant         *Tenant
+	Users          *User
 }
 
 type ClientConfig struct {
@@ -88,9 +92,11 @@ func NewClient(ctx context.Context, config ClientConfig) (*Client, error) {
 		ScopeGroup:     NewScopeGroup(config),
 		GroupType:      NewGroupType(config),
 		UserGroup:      NewUserGroup(config),
+		GroupFields:    NewGroupCustomField(config),
 		HostedPages:    NewHostedPage(config),
 		Webhook:        NewWebhook(config),
 		Apps:           NewApp(config),
+		AppGroups:      NewAppGroup(config),
 		RegFields:      NewRegField(config),
 		TemplateGroup:  NewTemplateGroup(config),
 		Templates:      NewTemplate(config),
@@ -99,6 +105,8 @@ func NewClient(ctx context.Context, config ClientConfig) (*Client, error) {
 		ConsentGroup:   NewConsentGroup(config),
 		Consent:        NewConsent(config),
 		ConsentVersion: NewConsentVersion(config),
+		Tenant:         NewTenant(config),
+		Users:          NewUser(config),
 	}
 	return client, nil
 }
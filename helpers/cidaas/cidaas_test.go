@@ -172,6 +172,9 @@ func TestNewClient_Success(t *testing.T) {
 	if client.UserGroup == nil {
 		t.Error("Expected UserGroup to be initialized")
 	}
+	if client.GroupFields == nil {
+		t.Error("Expected GroupFields to be initialized")
+	}
 	if client.HostedPages == nil {
 		t.Error("Expected HostedPages to be initialized")
 	}
@@ -181,6 +184,9 @@ func TestNewClient_Success(t *testing.T) {
 	if client.Apps == nil {
 		t.Error("Expected Apps to be initialized")
 	}
+	if client.AppGroups == nil {
+		t.Error("Expected AppGroups to be initialized")
+	}
 	if client.RegFields == nil {
 		t.Error("Expected RegFields to be initialized")
 	}
@@ -199,6 +205,12 @@ func TestNewClient_Success(t *testing.T) {
 	if client.ConsentVersion == nil {
 		t.Error("Expected ConsentVersion to be initialized")
 	}
+	if client.Tenant == nil {
+		t.Error("Expected Tenant to be initialized")
+	}
+	if client.Users == nil {
+		t.Error("Expected Users to be initialized")
+	}
 }
 
 func TestNewClient_URLCleanup(t *testing.T) {
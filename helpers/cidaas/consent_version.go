@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
 )
@@ -141,3 +142,30 @@ func (c *ConsentVersion) GetLocal(ctx context.Context, consentVersionID string,
 	}
 	return &response, nil
 }
+
+const consentVersionsEndpoint = "consent-management-srv/v2/consent/versions"
+
+func (c *ConsentVersion) Delete(ctx context.Context, consentVersionID string) error {
+	if consentVersionID == "" {
+		return fmt.Errorf("consentVersionID cannot be empty")
+	}
+	res, err := c.makeRequest(ctx, http.MethodDelete, fmt.Sprintf("%s/%s", consentVersionsEndpoint, consentVersionID), nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete consent version: %w", err)
+	}
+	defer res.Body.Close()
+	return nil
+}
+
+func (c *ConsentVersion) DeleteLocal(ctx context.Context, consentVersionID string, locale string) error {
+	if consentVersionID == "" || locale == "" {
+		return fmt.Errorf("consentVersionID and locale cannot be empty")
+	}
+	endpoint := fmt.Sprintf("%s/%s?locale=%s", "consent-management-srv/v2/consent/locale", consentVersionID, url.QueryEscape(locale))
+	res, err := c.makeRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete consent locale: %w", err)
+	}
+	defer res.Body.Close()
+	return nil
+}
@@ -920,3 +920,76 @@ func TestConsentVersion_GetLocal_MultipleLocales(t *testing.T) {
 		}
 	}
 }
+
+func TestConsentVersion_Delete_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE method, got %s", r.Method)
+		}
+		if r.URL.Path != "/consent-management-srv/v2/consent/versions/cv-123" {
+			t.Errorf("Expected /consent-management-srv/v2/consent/versions/cv-123, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := NewTestClientConfig(server.URL)
+	consentVersion := NewConsentVersion(config)
+
+	if err := consentVersion.Delete(context.Background(), "cv-123"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+}
+
+func TestConsentVersion_Delete_EmptyID(t *testing.T) {
+	config := NewTestClientConfig("http://test.com")
+	consentVersion := NewConsentVersion(config)
+
+	if err := consentVersion.Delete(context.Background(), ""); err == nil {
+		t.Error("Expected error for empty consent version id, got nil")
+	}
+}
+
+func TestConsentVersion_Delete_ServerError(t *testing.T) {
+	server := NewMockServer(http.StatusBadRequest, `{"error": "consent version is in use"}`)
+	defer server.Close()
+
+	config := NewTestClientConfig(server.URL)
+	consentVersion := NewConsentVersion(config)
+
+	if err := consentVersion.Delete(context.Background(), "cv-123"); err == nil {
+		t.Error("Expected error for server error, got nil")
+	}
+}
+
+func TestConsentVersion_DeleteLocal_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE method, got %s", r.Method)
+		}
+		if r.URL.Path != "/consent-management-srv/v2/consent/locale/cv-123" {
+			t.Errorf("Expected /consent-management-srv/v2/consent/locale/cv-123, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("locale") != "de-de" {
+			t.Errorf("Expected locale de-de, got %s", r.URL.Query().Get("locale"))
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := NewTestClientConfig(server.URL)
+	consentVersion := NewConsentVersion(config)
+
+	if err := consentVersion.DeleteLocal(context.Background(), "cv-123", "de-de"); err != nil {
+		t.Fatalf("DeleteLocal failed: %v", err)
+	}
+}
+
+func TestConsentVersion_DeleteLocal_EmptyParameters(t *testing.T) {
+	config := NewTestClientConfig("http://test.com")
+	consentVersion := NewConsentVersion(config)
+
+	if err := consentVersion.DeleteLocal(context.Background(), "cv-123", ""); err == nil {
+		t.Error("Expected error for empty locale, got nil")
+	}
+}
@@ -0,0 +1,117 @@
+package cidaas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
+)
+
+var AllowedGroupCustomFieldDataTypes = []string{"TEXT", "NUMBER", "BOOLEAN", "DATE"}
+
+type GroupCustomFieldModel struct {
+	ID          string   `json:"_id,omitempty"`
+	FieldKey    string   `json:"fieldKey,omitempty"`
+	DataType    string   `json:"dataType,omitempty"`
+	Required    bool     `json:"required"`
+	GroupTypes  []string `json:"groupTypes,omitempty"`
+	CreatedTime string   `json:"createdTime,omitempty"`
+	UpdatedTime string   `json:"updatedTime,omitempty"`
+}
+
+type GroupCustomFieldResponse struct {
+	Success bool                  `json:"success,omitempty"`
+	Status  int                   `json:"status,omitempty"`
+	Data    GroupCustomFieldModel `json:"data,omitempty"`
+}
+
+type AllGroupCustomFieldResponse struct {
+	Success bool                    `json:"success,omitempty"`
+	Status  int                     `json:"status,omitempty"`
+	Data    []GroupCustomFieldModel `json:"data,omitempty"`
+}
+
+type GroupCustomField struct {
+	ClientConfig
+}
+
+func NewGroupCustomField(clientConfig ClientConfig) *GroupCustomField {
+	return &GroupCustomField{clientConfig}
+}
+
+const groupCustomFieldEndpoint = "groups-srv/fieldsetup"
+
+func (g *GroupCustomField) Create(ctx context.Context, field GroupCustomFieldModel) (*GroupCustomFieldResponse, error) {
+	res, err := g.makeRequest(ctx, http.MethodPost, groupCustomFieldEndpoint, field)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create group custom field: %w", err)
+	}
+	defer res.Body.Close()
+
+	var response GroupCustomFieldResponse
+	if err = util.ProcessResponse(res, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (g *GroupCustomField) Get(ctx context.Context, fieldKey string) (*GroupCustomFieldResponse, error) {
+	if fieldKey == "" {
+		return nil, fmt.Errorf("fieldKey cannot be empty")
+	}
+	endpoint := fmt.Sprintf("%s/%s", groupCustomFieldEndpoint, fieldKey)
+	res, err := g.makeRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group custom field: %w", err)
+	}
+	defer res.Body.Close()
+
+	var response GroupCustomFieldResponse
+	if err = util.ProcessResponse(res, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (g *GroupCustomField) Update(ctx context.Context, field GroupCustomFieldModel) (*GroupCustomFieldResponse, error) {
+	res, err := g.makeRequest(ctx, http.MethodPut, groupCustomFieldEndpoint, field)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update group custom field: %w", err)
+	}
+	defer res.Body.Close()
+
+	var response GroupCustomFieldResponse
+	if err = util.ProcessResponse(res, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (g *GroupCustomField) Delete(ctx context.Context, fieldKey string) error {
+	if fieldKey == "" {
+		return fmt.Errorf("fieldKey cannot be empty")
+	}
+	endpoint := fmt.Sprintf("%s/%s", groupCustomFieldEndpoint, fieldKey)
+	res, err := g.makeRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete group custom field: %w", err)
+	}
+	defer res.Body.Close()
+	return nil
+}
+
+func (g *GroupCustomField) GetAll(ctx context.Context) ([]GroupCustomFieldModel, error) {
+	endpoint := fmt.Sprintf("%s/list", groupCustomFieldEndpoint)
+	res, err := g.makeRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get all group custom fields: %w", err)
+	}
+	defer res.Body.Close()
+
+	var response AllGroupCustomFieldResponse
+	if err = util.ProcessResponse(res, &response); err != nil {
+		return nil, err
+	}
+	return response.Data, nil
+}
@@ -0,0 +1,245 @@
+// helpers/cidaas/group_custom_field_test.go
+package cidaas
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNewGroupCustomField(t *testing.T) {
+	config := NewTestClientConfig("http://test.com")
+
+	field := NewGroupCustomField(config)
+
+	if field == nil {
+		t.Fatal("Expected group custom field instance, got nil")
+	}
+
+	if field.BaseURL != config.BaseURL {
+		t.Errorf("Expected BaseURL %s, got %s", config.BaseURL, field.BaseURL)
+	}
+
+	if field.AccessToken != config.AccessToken {
+		t.Errorf("Expected AccessToken %s, got %s", config.AccessToken, field.AccessToken)
+	}
+}
+
+func TestGroupCustomField_Create_Success(t *testing.T) {
+	expectedField := GroupCustomFieldModel{
+		ID:         "field-123",
+		FieldKey:   "cost_center",
+		DataType:   "TEXT",
+		Required:   true,
+		GroupTypes: []string{"department"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST method, got %s", r.Method)
+		}
+
+		if !strings.Contains(r.URL.Path, "groups-srv/fieldsetup") {
+			t.Errorf("Expected groups-srv/fieldsetup endpoint, got %s", r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var receivedField GroupCustomFieldModel
+		json.Unmarshal(body, &receivedField)
+
+		if receivedField.FieldKey != expectedField.FieldKey {
+			t.Errorf("Expected FieldKey %s, got %s", expectedField.FieldKey, receivedField.FieldKey)
+		}
+
+		if !receivedField.Required {
+			t.Error("Expected Required to be true")
+		}
+
+		response := GroupCustomFieldResponse{
+			Success: true,
+			Status:  200,
+			Data:    expectedField,
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	field := NewGroupCustomField(NewTestClientConfig(server.URL))
+
+	result, err := field.Create(context.Background(), expectedField)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	if result.Data.ID != expectedField.ID {
+		t.Errorf("Expected ID %s, got %s", expectedField.ID, result.Data.ID)
+	}
+
+	if len(result.Data.GroupTypes) != 1 || result.Data.GroupTypes[0] != "department" {
+		t.Errorf("Expected GroupTypes [department], got %v", result.Data.GroupTypes)
+	}
+}
+
+func TestGroupCustomField_Create_ServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error": "field already exists"}`))
+	}))
+	defer server.Close()
+
+	field := NewGroupCustomField(NewTestClientConfig(server.URL))
+
+	_, err := field.Create(context.Background(), GroupCustomFieldModel{FieldKey: "cost_center"})
+	if err == nil {
+		t.Fatal("Expected error for server error, got nil")
+	}
+
+	if !strings.Contains(err.Error(), "failed to create group custom field") {
+		t.Errorf("Expected 'failed to create group custom field' in error, got %s", err.Error())
+	}
+}
+
+func TestGroupCustomField_Get_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET method, got %s", r.Method)
+		}
+
+		if !strings.HasSuffix(r.URL.Path, "groups-srv/fieldsetup/cost_center") {
+			t.Errorf("Expected field key in URL path, got %s", r.URL.Path)
+		}
+
+		response := GroupCustomFieldResponse{
+			Success: true,
+			Status:  200,
+			Data: GroupCustomFieldModel{
+				ID:       "field-123",
+				FieldKey: "cost_center",
+				DataType: "NUMBER",
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	field := NewGroupCustomField(NewTestClientConfig(server.URL))
+
+	result, err := field.Get(context.Background(), "cost_center")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+
+	if result.Data.DataType != "NUMBER" {
+		t.Errorf("Expected DataType NUMBER, got %s", result.Data.DataType)
+	}
+}
+
+func TestGroupCustomField_Get_EmptyFieldKey(t *testing.T) {
+	field := NewGroupCustomField(ClientConfig{})
+
+	_, err := field.Get(context.Background(), "")
+	if err == nil {
+		t.Fatal("Expected error for empty field key, got nil")
+	}
+
+	if err.Error() != "fieldKey cannot be empty" {
+		t.Errorf("Expected 'fieldKey cannot be empty', got %s", err.Error())
+	}
+}
+
+func TestGroupCustomField_Update_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT method, got %s", r.Method)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var receivedField GroupCustomFieldModel
+		json.Unmarshal(body, &receivedField)
+
+		response := GroupCustomFieldResponse{
+			Success: true,
+			Status:  200,
+			Data:    receivedField,
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	field := NewGroupCustomField(NewTestClientConfig(server.URL))
+
+	result, err := field.Update(context.Background(), GroupCustomFieldModel{FieldKey: "cost_center", DataType: "TEXT"})
+	if err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	if result.Data.DataType != "TEXT" {
+		t.Errorf("Expected DataType TEXT, got %s", result.Data.DataType)
+	}
+}
+
+func TestGroupCustomField_Delete_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE method, got %s", r.Method)
+		}
+
+		if !strings.HasSuffix(r.URL.Path, "groups-srv/fieldsetup/cost_center") {
+			t.Errorf("Expected field key in URL path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	field := NewGroupCustomField(NewTestClientConfig(server.URL))
+
+	if err := field.Delete(context.Background(), "cost_center"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+}
+
+func TestGroupCustomField_GetAll_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET method, got %s", r.Method)
+		}
+
+		if !strings.HasSuffix(r.URL.Path, "groups-srv/fieldsetup/list") {
+			t.Errorf("Expected groups-srv/fieldsetup/list endpoint, got %s", r.URL.Path)
+		}
+
+		response := AllGroupCustomFieldResponse{
+			Success: true,
+			Status:  200,
+			Data: []GroupCustomFieldModel{
+				{FieldKey: "cost_center", DataType: "TEXT"},
+				{FieldKey: "headcount", DataType: "NUMBER"},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	field := NewGroupCustomField(NewTestClientConfig(server.URL))
+
+	result, err := field.GetAll(context.Background())
+	if err != nil {
+		t.Fatalf("GetAll failed: %v", err)
+	}
+
+	if len(result) != 2 {
+		t.Fatalf("Expected 2 fields, got %d", len(result))
+	}
+
+	if result[1].FieldKey != "headcount" {
+		t.Errorf("Expected FieldKey headcount, got %s", result[1].FieldKey)
+	}
+}
@@ -156,3 +156,23 @@ func (r *RegField) GetAll(ctx context.Context) ([]RegistrationFieldConfig, error
 	}
 	return response.Data, nil
 }
+
+type RegistrationFieldOrder struct {
+	FieldKey string `json:"fieldKey"`
+	Order    int64  `json:"order"`
+}
+
+const regFieldOrderEndpoint = "fieldsetup-srv/fields/order"
+
+// UpdateOrder sets the order of the registration fields on the registration form.
+func (r *RegField) UpdateOrder(ctx context.Context, order []RegistrationFieldOrder) error {
+	if len(order) == 0 {
+		return fmt.Errorf("order cannot be empty")
+	}
+	res, err := r.makeRequest(ctx, http.MethodPost, regFieldOrderEndpoint, order)
+	if err != nil {
+		return fmt.Errorf("failed to update registration field order: %w", err)
+	}
+	defer res.Body.Close()
+	return nil
+}
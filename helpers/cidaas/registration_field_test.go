@@ -813,3 +813,65 @@ func TestRegField_GetAll_Error(t *testing.T) {
 		t.Error("Expected error for server error, got nil")
 	}
 }
+
+func TestRegField_UpdateOrder_Success(t *testing.T) {
+	order := []RegistrationFieldOrder{
+		{FieldKey: "given_name", Order: 1},
+		{FieldKey: "email", Order: 2},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST method, got %s", r.Method)
+		}
+
+		if !strings.Contains(r.URL.Path, "fieldsetup-srv/fields/order") {
+			t.Errorf("Expected fieldsetup-srv/fields/order endpoint, got %s", r.URL.Path)
+		}
+
+		var requestBody []RegistrationFieldOrder
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &requestBody); err != nil {
+			t.Fatalf("Failed to unmarshal request body: %v", err)
+		}
+		if len(requestBody) != 2 || requestBody[0].FieldKey != "given_name" || requestBody[1].Order != 2 {
+			t.Errorf("Unexpected request body %+v", requestBody)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"success": true, "status": 200}`))
+	}))
+	defer server.Close()
+
+	config := NewTestClientConfig(server.URL)
+	regField := NewRegField(config)
+
+	if err := regField.UpdateOrder(context.Background(), order); err != nil {
+		t.Fatalf("UpdateOrder failed: %v", err)
+	}
+}
+
+func TestRegField_UpdateOrder_EmptyOrder(t *testing.T) {
+	config := NewTestClientConfig("http://test.com")
+	regField := NewRegField(config)
+
+	err := regField.UpdateOrder(context.Background(), nil)
+
+	if err == nil {
+		t.Error("Expected error for empty order, got nil")
+	}
+}
+
+func TestRegField_UpdateOrder_Error(t *testing.T) {
+	server := NewMockServer(http.StatusBadRequest, `{"error": "invalid field key"}`)
+	defer server.Close()
+
+	config := NewTestClientConfig(server.URL)
+	regField := NewRegField(config)
+
+	err := regField.UpdateOrder(context.Background(), []RegistrationFieldOrder{{FieldKey: "email", Order: 1}})
+
+	if err == nil {
+		t.Error("Expected error for bad request, got nil")
+	}
+}
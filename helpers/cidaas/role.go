@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
 )
@@ -95,3 +96,75 @@ func (r *Role) GetAll(ctx context.Context) ([]RoleModel, error) {
 	}
 	return response.Data, nil
 }
+
+type UserRoleModel struct {
+	Sub  string `json:"sub,omitempty"`
+	Role string `json:"role,omitempty"`
+}
+
+type UserRoleResponse struct {
+	Success bool          `json:"success,omitempty"`
+	Status  int           `json:"status,omitempty"`
+	Data    UserRoleModel `json:"data,omitempty"`
+}
+
+type RoleMembersResponse struct {
+	Success bool            `json:"success,omitempty"`
+	Status  int             `json:"status,omitempty"`
+	Data    []UserRoleModel `json:"data,omitempty"`
+}
+
+const userRolesEndpoint = "roles-srv/userroles"
+
+func (r *Role) AssignUser(ctx context.Context, userRole UserRoleModel) (*UserRoleResponse, error) {
+	if userRole.Role == "" || userRole.Sub == "" {
+		return nil, fmt.Errorf("role and sub cannot be empty")
+	}
+	res, err := r.makeRequest(ctx, http.MethodPost, userRolesEndpoint, userRole)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assign role to user: %w", err)
+	}
+	defer res.Body.Close()
+
+	var response UserRoleResponse
+	if err = util.ProcessResponse(res, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (r *Role) UnassignUser(ctx context.Context, userRole UserRoleModel) error {
+	if userRole.Role == "" || userRole.Sub == "" {
+		return fmt.Errorf("role and sub cannot be empty")
+	}
+	endpoint := fmt.Sprintf("%s?role=%s&sub=%s", userRolesEndpoint, url.QueryEscape(userRole.Role), url.QueryEscape(userRole.Sub))
+	res, err := r.makeRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to unassign role from user: %w", err)
+	}
+	defer res.Body.Close()
+	return nil
+}
+
+// GetMembers returns the subs of all users the role is assigned to.
+func (r *Role) GetMembers(ctx context.Context, role string) ([]string, error) {
+	if role == "" {
+		return nil, fmt.Errorf("role cannot be empty")
+	}
+	endpoint := fmt.Sprintf("%s?role=%s", userRolesEndpoint, url.QueryEscape(role))
+	res, err := r.makeRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role members: %w", err)
+	}
+	defer res.Body.Close()
+
+	var response RoleMembersResponse
+	if err = util.ProcessResponse(res, &response); err != nil {
+		return nil, err
+	}
+	subs := make([]string, 0, len(response.Data))
+	for _, member := range response.Data {
+		subs = append(subs, member.Sub)
+	}
+	return subs, nil
+}
@@ -462,3 +462,106 @@ func TestRole_UpsertRole_ContextCancellation(t *testing.T) {
 		t.Error("Expected context cancellation error, got nil")
 	}
 }
+
+func TestRole_AssignUser_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST method, got %s", r.Method)
+		}
+
+		if !strings.HasSuffix(r.URL.Path, "roles-srv/userroles") {
+			t.Errorf("Expected roles-srv/userroles endpoint, got %s", r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var received UserRoleModel
+		json.Unmarshal(body, &received)
+
+		if received.Role != "ADMIN" || received.Sub != "user-sub" {
+			t.Errorf("Expected role ADMIN and sub user-sub, got %+v", received)
+		}
+
+		response := UserRoleResponse{
+			Success: true,
+			Status:  200,
+			Data:    received,
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	role := NewRole(NewTestClientConfig(server.URL))
+
+	if _, err := role.AssignUser(context.Background(), UserRoleModel{Role: "ADMIN", Sub: "user-sub"}); err != nil {
+		t.Fatalf("AssignUser failed: %v", err)
+	}
+}
+
+func TestRole_AssignUser_EmptySub(t *testing.T) {
+	role := NewRole(ClientConfig{})
+
+	_, err := role.AssignUser(context.Background(), UserRoleModel{Role: "ADMIN"})
+	if err == nil {
+		t.Fatal("Expected error for empty sub, got nil")
+	}
+
+	if err.Error() != "role and sub cannot be empty" {
+		t.Errorf("Expected 'role and sub cannot be empty', got %s", err.Error())
+	}
+}
+
+func TestRole_UnassignUser_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE method, got %s", r.Method)
+		}
+
+		if r.URL.Query().Get("role") != "ADMIN" || r.URL.Query().Get("sub") != "user-sub" {
+			t.Errorf("Expected role and sub query parameters, got %s", r.URL.RawQuery)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	role := NewRole(NewTestClientConfig(server.URL))
+
+	if err := role.UnassignUser(context.Background(), UserRoleModel{Role: "ADMIN", Sub: "user-sub"}); err != nil {
+		t.Fatalf("UnassignUser failed: %v", err)
+	}
+}
+
+func TestRole_GetMembers_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET method, got %s", r.Method)
+		}
+
+		if r.URL.Query().Get("role") != "ADMIN" {
+			t.Errorf("Expected role query parameter ADMIN, got %s", r.URL.RawQuery)
+		}
+
+		response := RoleMembersResponse{
+			Success: true,
+			Status:  200,
+			Data: []UserRoleModel{
+				{Role: "ADMIN", Sub: "sub-1"},
+				{Role: "ADMIN", Sub: "sub-2"},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	role := NewRole(NewTestClientConfig(server.URL))
+
+	subs, err := role.GetMembers(context.Background(), "ADMIN")
+	if err != nil {
+		t.Fatalf("GetMembers failed: %v", err)
+	}
+
+	if len(subs) != 2 || subs[0] != "sub-1" || subs[1] != "sub-2" {
+		t.Errorf("Expected subs [sub-1 sub-2], got %v", subs)
+	}
+}
@@ -99,6 +99,22 @@ func (c *Scope) Delete(ctx context.Context, scopeKey string) error {
 	return nil
 }
 
+// DeleteLocale removes the description of a single locale from the scope. Upsert only adds or updates
+// the provided locales, a locale missing in the payload is kept by cidaas.
+func (c *Scope) DeleteLocale(ctx context.Context, scopeKey, locale string) error {
+	url := fmt.Sprintf("%s/%s/%s/locale/%s", c.BaseURL, "scopes-srv/scope", strings.ToLower(scopeKey), locale)
+	client, err := util.NewHTTPClient(url, http.MethodDelete, c.AccessToken)
+	if err != nil {
+		return err
+	}
+	res, err := client.MakeRequest(ctx, nil)
+	if err = util.HandleResponseError(res, err); err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return nil
+}
+
 func (c *Scope) GetAll(ctx context.Context) ([]ScopeModel, error) {
 	var response AllScopeResp
 	url := fmt.Sprintf("%s/%s", c.BaseURL, "scopes-srv/scope/list")
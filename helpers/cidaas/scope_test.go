@@ -517,6 +517,46 @@ func TestScope_Delete_NotFound(t *testing.T) {
 	}
 }
 
+func TestScope_DeleteLocale_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE method, got %s", r.Method)
+		}
+
+		// scope key is converted to lowercase, the locale is kept as provided
+		expectedPath := "/scopes-srv/scope/read:profile/locale/de-DE"
+		if r.URL.Path != expectedPath {
+			t.Errorf("Expected path %s, got %s", expectedPath, r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := NewTestClientConfig(server.URL)
+	scope := NewScope(config)
+
+	err := scope.DeleteLocale(context.Background(), "READ:PROFILE", "de-DE")
+
+	if err != nil {
+		t.Fatalf("DeleteLocale failed: %v", err)
+	}
+}
+
+func TestScope_DeleteLocale_NotFound(t *testing.T) {
+	server := NewMockServer(http.StatusNotFound, `{"error": "locale not found"}`)
+	defer server.Close()
+
+	config := NewTestClientConfig(server.URL)
+	scope := NewScope(config)
+
+	err := scope.DeleteLocale(context.Background(), "test:scope", "de-DE")
+
+	if err == nil {
+		t.Error("Expected error for not found locale, got nil")
+	}
+}
+
 func TestScope_GetAll_Success(t *testing.T) {
 	expectedScopes := []ScopeModel{
 		{
@@ -33,6 +33,12 @@ type TemplateResponse struct {
 	Data    TemplateModel `json:"data,omitempty"`
 }
 
+type TemplateListResponse struct {
+	Success bool            `json:"success,omitempty"`
+	Status  int             `json:"status,omitempty"`
+	Data    []TemplateModel `json:"data,omitempty"`
+}
+
 type MasterListResponse struct {
 	Success bool         `json:"success,omitempty"`
 	Status  int          `json:"status,omitempty"`
@@ -154,6 +160,56 @@ func (t *Template) Delete(ctx context.Context, templateKey string, templateType
 	return nil
 }
 
+const customTemplatesEndpoint = "templates-srv/template/custom"
+
+// GetAllLocales returns the custom templates of all locales configured for the template_key and template_type.
+func (t *Template) GetAllLocales(ctx context.Context, templateKey, templateType string) ([]TemplateModel, error) {
+	if templateKey == "" || templateType == "" {
+		return nil, fmt.Errorf("templateKey and templateType cannot be empty")
+	}
+	body := TemplateModel{
+		TemplateKey:  strings.ToUpper(templateKey),
+		TemplateType: strings.ToUpper(templateType),
+	}
+	return t.listCustom(ctx, body)
+}
+
+// GetAll returns the custom templates of all template keys, template types and locales.
+func (t *Template) GetAll(ctx context.Context) ([]TemplateModel, error) {
+	return t.listCustom(ctx, map[string]string{})
+}
+
+func (t *Template) listCustom(ctx context.Context, filter interface{}) ([]TemplateModel, error) {
+	res, err := t.makeRequest(ctx, http.MethodPost, customTemplatesEndpoint+"/list", filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list templates: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNoContent {
+		return []TemplateModel{}, nil
+	}
+	var response TemplateListResponse
+	if err = util.ProcessResponse(res, &response); err != nil {
+		return nil, err
+	}
+	return response.Data, nil
+}
+
+// DeleteLocale deletes the custom template of a single locale, the templates of the other locales are not affected.
+func (t *Template) DeleteLocale(ctx context.Context, templateKey, templateType, locale string) error {
+	if templateKey == "" || templateType == "" || locale == "" {
+		return fmt.Errorf("templateKey, templateType and locale cannot be empty")
+	}
+	endpoint := fmt.Sprintf("%s/%s/%s/%s", customTemplatesEndpoint, strings.ToUpper(templateKey), strings.ToUpper(templateType), strings.ToLower(locale))
+	res, err := t.makeRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete template locale: %w", err)
+	}
+	defer res.Body.Close()
+	return nil
+}
+
 func (t *Template) GetMasterList(ctx context.Context, groupID string) (*MasterListResponse, error) {
 	var response MasterListResponse
 	url := fmt.Sprintf("%s/%s/%s", t.BaseURL, "templates-srv/master/settings", groupID)
@@ -0,0 +1,247 @@
+package cidaas
+
+import (
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	LINK    = "LINK"
+	CODE    = "CODE"
+	IVR     = "IVR"
+	EMAIL   = "EMAIL"
+	SMS     = "SMS"
+	PUSH    = "PUSH"
+	GENERAL = "GENERAL"
+)
+
+type TemplateTags struct {
+	Required []string
+	Optional []string
+}
+
+type TemplateData struct {
+	TemplateType   string
+	ProcessingType string
+}
+
+type TagsListPayload struct {
+	VerifyAccountEmailSMSLink   TemplateTags
+	VerifyAccountEmailSMSCode   TemplateTags
+	VerifyAccountIVRCode        TemplateTags
+	WelcomeUserEmailSMSLink     TemplateTags
+	WelcomeUserIVRLink          TemplateTags
+	InviteUserEmail             TemplateTags
+	ResetPasswordEmailLink      TemplateTags
+	ResetPasswordEmailCode      TemplateTags
+	ResetPasswordSMS            TemplateTags
+	ResetPasswordIVR            TemplateTags
+	AfterChangePasswordEmailSMS TemplateTags
+	AfterChangePasswordIVR      TemplateTags
+	UserCreatedEmailSMS         TemplateTags
+	VerifyUserEmailLink         TemplateTags
+	VerifyUserCodeGeneral       TemplateTags
+	VerifyUserSMSIVR            TemplateTags
+	VerifyUserPush              TemplateTags
+	NotifyCommunicationChange   TemplateTags
+}
+
+type TemplateTagHandler struct {
+	TemplateData    TemplateData
+	TagsListPayload TagsListPayload
+}
+
+func (th *TemplateTagHandler) GetTemplateTags(text string) TemplateTags { //nolint:gocognit
+	var tags TemplateTags
+
+	switch text {
+	case "VERIFY_ACCOUNT":
+		if th.TemplateData.TemplateType == EMAIL || th.TemplateData.TemplateType == SMS {
+			if th.TemplateData.ProcessingType == LINK {
+				tags = th.TagsListPayload.VerifyAccountEmailSMSLink
+			} else if th.TemplateData.ProcessingType == CODE {
+				tags = th.TagsListPayload.VerifyAccountEmailSMSCode
+			}
+		} else if th.TemplateData.TemplateType == IVR {
+			tags = th.TagsListPayload.VerifyAccountIVRCode
+		}
+	case "WELCOME_USER":
+		if th.TemplateData.TemplateType == EMAIL || th.TemplateData.TemplateType == SMS {
+			tags = th.TagsListPayload.WelcomeUserEmailSMSLink
+		} else if th.TemplateData.TemplateType == IVR {
+			tags = th.TagsListPayload.WelcomeUserIVRLink
+		}
+	case "INVITE_USER":
+		tags = th.TagsListPayload.InviteUserEmail
+	case "RESET_PASSWORD":
+		if th.TemplateData.TemplateType == EMAIL {
+			if th.TemplateData.ProcessingType == LINK {
+				tags = th.TagsListPayload.ResetPasswordEmailLink
+			} else if th.TemplateData.ProcessingType == CODE {
+				tags = th.TagsListPayload.ResetPasswordEmailCode
+			}
+		} else if th.TemplateData.TemplateType == SMS {
+			tags = th.TagsListPayload.ResetPasswordSMS
+		} else if th.TemplateData.TemplateType == IVR {
+			tags = th.TagsListPayload.ResetPasswordIVR
+		}
+	case "CHANGE_PASSWORD", "AFTER_CHANGE_PASSWORD":
+		if th.TemplateData.TemplateType == EMAIL || th.TemplateData.TemplateType == SMS {
+			tags = th.TagsListPayload.AfterChangePasswordEmailSMS
+		} else if th.TemplateData.TemplateType == IVR {
+			tags = th.TagsListPayload.AfterChangePasswordIVR
+		}
+	case "NEW_DEVICE", "NEW_LOCATION", "NEW_NETWORK":
+		tags = TemplateTags{
+			Optional: []string{"{{name}}", "{{account_name}}}"},
+		}
+	case "USER_CREATED":
+		tags = th.TagsListPayload.UserCreatedEmailSMS
+	case "VERIFY_USER":
+		if th.TemplateData.TemplateType == EMAIL {
+			if th.TemplateData.ProcessingType == LINK {
+				tags = th.TagsListPayload.VerifyUserEmailLink
+			} else if th.TemplateData.ProcessingType == CODE || th.TemplateData.ProcessingType == GENERAL {
+				tags = th.TagsListPayload.VerifyUserCodeGeneral
+			}
+		} else if th.TemplateData.TemplateType == SMS || th.TemplateData.TemplateType == IVR {
+			tags = th.TagsListPayload.VerifyUserSMSIVR
+		} else if th.TemplateData.TemplateType == PUSH {
+			tags = th.TagsListPayload.VerifyUserPush
+		}
+	case "NOTIFY_COMMUNICATION_CHANGE":
+		tags = th.TagsListPayload.NotifyCommunicationChange
+	}
+	return tags
+}
+
+func (th *TemplateTagHandler) AddSupportedTags() {
+	th.TagsListPayload = TagsListPayload{
+		VerifyAccountEmailSMSLink: TemplateTags{
+			Required: []string{"{{{verify_link}}}"},
+			Optional: []string{"{{name}}", "{{account_name}}}"},
+		},
+		VerifyAccountEmailSMSCode: TemplateTags{
+			Required: []string{"{{code}}", "{{{verify_link}}}"},
+			Optional: []string{"{{name}}", "{{account_name}}}"},
+		},
+		VerifyAccountIVRCode: TemplateTags{
+			Required: []string{"{{code}}"},
+		},
+		WelcomeUserEmailSMSLink: TemplateTags{
+			Required: []string{"{{{login_link}}}"},
+			Optional: []string{"{{name}}", "{{account_name}}}"},
+		},
+		WelcomeUserIVRLink: TemplateTags{
+			Optional: []string{"{{name}}", "{{account_name}}}"},
+		},
+		InviteUserEmail: TemplateTags{
+			Required: []string{"{{{invite_link}}}"},
+			Optional: []string{"{{name}}", "{{account_name}}}", "{{{invited_by}}}"},
+		},
+		ResetPasswordEmailLink: TemplateTags{
+			Required: []string{"{{{reset_link}}}"},
+			Optional: []string{"{{name}}", "{{account_name}}}"},
+		},
+		ResetPasswordEmailCode: TemplateTags{
+			Required: []string{"{{code}}, {{{reset_link}}}"},
+			Optional: []string{"{{name}}", "{{account_name}}}"},
+		},
+		ResetPasswordSMS: TemplateTags{
+			Required: []string{"{{code}}"},
+			Optional: []string{"{{name}}", "{{account_name}}}"},
+		},
+		ResetPasswordIVR: TemplateTags{
+			Required: []string{"{{code}}"},
+		},
+		AfterChangePasswordEmailSMS: TemplateTags{
+			Required: []string{"{{{reset_link}}}"},
+			Optional: []string{"{{name}}", "{{account_name}}}"},
+		},
+		AfterChangePasswordIVR: TemplateTags{
+			Optional: []string{"{{name}}", "{{account_name}}}"},
+		},
+		UserCreatedEmailSMS: TemplateTags{
+			Optional: []string{"{{name}}", "{{account_name}}}", "{{user_name}}", "{{password}}", "{{{login_link}}}"},
+		},
+		VerifyUserEmailLink: TemplateTags{
+			Optional: []string{"{{name}}", "{{code}}}"},
+			Required: []string{"{{verify_link}}"},
+		},
+		VerifyUserCodeGeneral: TemplateTags{
+			Optional: []string{"{{name}}", "{{verify_link}}}"},
+			Required: []string{"{{code}}"},
+		},
+		VerifyUserSMSIVR: TemplateTags{
+			Optional: []string{"{{name}}"},
+			Required: []string{"{{code}}"},
+		},
+		VerifyUserPush: TemplateTags{
+			Required: []string{"{{address}}"},
+		},
+		NotifyCommunicationChange: TemplateTags{
+			Optional: []string{"{{name}}", "{{account_name}}}", "{{communication_medium_value}}"},
+		},
+	}
+}
+
+// placeholderRegex matches the double and triple curly brace placeholders supported in templates, e.g. {{name}} and {{{verify_link}}}.
+var placeholderRegex = regexp.MustCompile(`{{{?\s*([a-zA-Z0-9_.]+)\s*}?}}`)
+
+// ParseTemplatePlaceholders returns the sorted, distinct placeholder names used in text without the curly braces.
+// Handlebars block helpers like {{#if name}} and {{else}} are not placeholders and are ignored.
+func ParseTemplatePlaceholders(text string) []string {
+	seen := map[string]bool{}
+	names := []string{}
+	for _, match := range placeholderRegex.FindAllStringSubmatch(text, -1) {
+		name := match[1]
+		if name == "else" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Names returns the placeholder names of the required and optional tags without the curly braces.
+func (t TemplateTags) Names() (required []string, optional []string) {
+	for _, tag := range t.Required {
+		required = append(required, ParseTemplatePlaceholders(tag)...)
+	}
+	for _, tag := range t.Optional {
+		optional = append(optional, ParseTemplatePlaceholders(tag)...)
+	}
+	return required, optional
+}
+
+// RenderTemplate replaces the placeholders in text with the provided values the way cidaas renders templates.
+// Values of double curly brace placeholders are HTML escaped, triple curly brace placeholders are inserted as they are.
+// Placeholders without a value are kept in the text and returned sorted as unresolved.
+func RenderTemplate(text string, values map[string]string) (rendered string, unresolved []string) {
+	missing := map[string]bool{}
+	rendered = placeholderRegex.ReplaceAllStringFunc(text, func(placeholder string) string {
+		name := placeholderRegex.FindStringSubmatch(placeholder)[1]
+		if name == "else" {
+			return placeholder
+		}
+		value, ok := values[name]
+		if !ok {
+			missing[name] = true
+			return placeholder
+		}
+		if strings.HasPrefix(placeholder, "{{{") {
+			return value
+		}
+		return html.EscapeString(value)
+	})
+	unresolved = []string{}
+	for name := range missing {
+		unresolved = append(unresolved, name)
+	}
+	sort.Strings(unresolved)
+	return rendered, unresolved
+}
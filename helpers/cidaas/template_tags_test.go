@@ -0,0 +1,131 @@
+// helpers/cidaas/template_tags_test.go
+package cidaas
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTemplatePlaceholders(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{
+			name:     "no placeholders",
+			text:     "Welcome to cidaas",
+			expected: []string{},
+		},
+		{
+			name:     "double and triple braces",
+			text:     "Hi {{name}}, click {{{verify_link}}} or use {{ code }}",
+			expected: []string{"code", "name", "verify_link"},
+		},
+		{
+			name:     "duplicates are removed",
+			text:     "{{name}} {{name}}",
+			expected: []string{"name"},
+		},
+		{
+			name:     "block helpers are ignored",
+			text:     "{{#if name}}Hi {{name}}{{else}}Hi{{/if}}",
+			expected: []string{"name"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseTemplatePlaceholders(tt.text)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestTemplateTagsNames(t *testing.T) {
+	th := TemplateTagHandler{
+		TemplateData: TemplateData{
+			TemplateType:   EMAIL,
+			ProcessingType: CODE,
+		},
+	}
+	th.AddSupportedTags()
+
+	required, optional := th.GetTemplateTags("RESET_PASSWORD").Names()
+
+	if !reflect.DeepEqual(required, []string{"code", "reset_link"}) {
+		t.Errorf("Expected required tags [code reset_link], got %v", required)
+	}
+	if !reflect.DeepEqual(optional, []string{"name", "account_name"}) {
+		t.Errorf("Expected optional tags [name account_name], got %v", optional)
+	}
+}
+
+func TestTemplateTagsNamesUnknownTemplateKey(t *testing.T) {
+	th := TemplateTagHandler{
+		TemplateData: TemplateData{
+			TemplateType:   SMS,
+			ProcessingType: GENERAL,
+		},
+	}
+	th.AddSupportedTags()
+
+	required, optional := th.GetTemplateTags("UNKNOWN_KEY").Names()
+
+	if len(required) != 0 || len(optional) != 0 {
+		t.Errorf("Expected no tags, got required %v and optional %v", required, optional)
+	}
+}
+
+func TestRenderTemplate(t *testing.T) {
+	tests := []struct {
+		name               string
+		text               string
+		values             map[string]string
+		expected           string
+		expectedUnresolved []string
+	}{
+		{
+			name:               "all placeholders resolved",
+			text:               "Hi {{name}}, click {{{verify_link}}}",
+			values:             map[string]string{"name": "John", "verify_link": "https://example.com/verify?a=1&b=2"},
+			expected:           "Hi John, click https://example.com/verify?a=1&b=2",
+			expectedUnresolved: []string{},
+		},
+		{
+			name:               "double braces are html escaped",
+			text:               "Hi {{ name }}",
+			values:             map[string]string{"name": "<John & Jane>"},
+			expected:           "Hi &lt;John &amp; Jane&gt;",
+			expectedUnresolved: []string{},
+		},
+		{
+			name:               "unresolved placeholders are kept",
+			text:               "Hi {{name}}, your code is {{code}} {{code}} for {{{account_name}}}",
+			values:             map[string]string{"name": "John"},
+			expected:           "Hi John, your code is {{code}} {{code}} for {{{account_name}}}",
+			expectedUnresolved: []string{"account_name", "code"},
+		},
+		{
+			name:               "block helpers are left untouched",
+			text:               "{{#if name}}Hi {{name}}{{else}}Hi{{/if}}",
+			values:             map[string]string{"name": "John"},
+			expected:           "{{#if name}}Hi John{{else}}Hi{{/if}}",
+			expectedUnresolved: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rendered, unresolved := RenderTemplate(tt.text, tt.values)
+			if rendered != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, rendered)
+			}
+			if !reflect.DeepEqual(unresolved, tt.expectedUnresolved) {
+				t.Errorf("Expected unresolved %v, got %v", tt.expectedUnresolved, unresolved)
+			}
+		})
+	}
+}
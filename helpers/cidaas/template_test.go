@@ -350,6 +350,140 @@ func TestTemplate_Delete_ServerError(t *testing.T) {
 	}
 }
 
+func TestTemplate_GetAllLocales_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST method, got %s", r.Method)
+		}
+		if r.URL.Path != "/templates-srv/template/custom/list" {
+			t.Errorf("Expected /templates-srv/template/custom/list, got %s", r.URL.Path)
+		}
+
+		var body TemplateModel
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+		if body.TemplateKey != "WELCOME_EMAIL" || body.TemplateType != "EMAIL" {
+			t.Errorf("Expected WELCOME_EMAIL and EMAIL in request body, got %s and %s", body.TemplateKey, body.TemplateType)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"success":true,"status":200,"data":[` +
+			`{"templateKey":"WELCOME_EMAIL","templateType":"EMAIL","locale":"en-us","content":"Welcome"},` +
+			`{"templateKey":"WELCOME_EMAIL","templateType":"EMAIL","locale":"de-de","content":"Willkommen"}]}`))
+	}))
+	defer server.Close()
+
+	config := NewTestClientConfig(server.URL)
+	template := NewTemplate(config)
+
+	templates, err := template.GetAllLocales(context.Background(), "welcome_email", "email")
+	if err != nil {
+		t.Fatalf("GetAllLocales failed: %v", err)
+	}
+	if len(templates) != 2 {
+		t.Fatalf("Expected 2 templates, got %d", len(templates))
+	}
+	if templates[1].Locale != "de-de" || templates[1].Content != "Willkommen" {
+		t.Errorf("Unexpected template %+v", templates[1])
+	}
+}
+
+func TestTemplate_GetAllLocales_NoContent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	config := NewTestClientConfig(server.URL)
+	template := NewTemplate(config)
+
+	templates, err := template.GetAllLocales(context.Background(), "WELCOME_EMAIL", "EMAIL")
+	if err != nil {
+		t.Fatalf("GetAllLocales failed: %v", err)
+	}
+	if len(templates) != 0 {
+		t.Errorf("Expected no templates, got %d", len(templates))
+	}
+}
+
+func TestTemplate_GetAllLocales_EmptyArguments(t *testing.T) {
+	config := NewTestClientConfig("http://test.com")
+	template := NewTemplate(config)
+
+	_, err := template.GetAllLocales(context.Background(), "", "EMAIL")
+	if err == nil {
+		t.Error("Expected error for empty templateKey, got nil")
+	}
+}
+
+func TestTemplate_GetAll_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST method, got %s", r.Method)
+		}
+		if r.URL.Path != "/templates-srv/template/custom/list" {
+			t.Errorf("Expected /templates-srv/template/custom/list, got %s", r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "{}" {
+			t.Errorf("Expected an empty filter, got %s", string(body))
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"success":true,"status":200,"data":[` +
+			`{"templateKey":"WELCOME_EMAIL","templateType":"EMAIL","locale":"en-us","content":"Welcome"},` +
+			`{"templateKey":"OTP","templateType":"SMS","locale":"en-us","content":"{{code}}"}]}`))
+	}))
+	defer server.Close()
+
+	config := NewTestClientConfig(server.URL)
+	template := NewTemplate(config)
+
+	templates, err := template.GetAll(context.Background())
+	if err != nil {
+		t.Fatalf("GetAll failed: %v", err)
+	}
+	if len(templates) != 2 {
+		t.Fatalf("Expected 2 templates, got %d", len(templates))
+	}
+	if templates[1].TemplateKey != "OTP" || templates[1].TemplateType != "SMS" {
+		t.Errorf("Unexpected template %+v", templates[1])
+	}
+}
+
+func TestTemplate_DeleteLocale_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE method, got %s", r.Method)
+		}
+		if r.URL.Path != "/templates-srv/template/custom/WELCOME_EMAIL/EMAIL/de-de" {
+			t.Errorf("Expected /templates-srv/template/custom/WELCOME_EMAIL/EMAIL/de-de, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := NewTestClientConfig(server.URL)
+	template := NewTemplate(config)
+
+	err := template.DeleteLocale(context.Background(), "welcome_email", "email", "DE-DE")
+	if err != nil {
+		t.Fatalf("DeleteLocale failed: %v", err)
+	}
+}
+
+func TestTemplate_DeleteLocale_EmptyLocale(t *testing.T) {
+	config := NewTestClientConfig("http://test.com")
+	template := NewTemplate(config)
+
+	err := template.DeleteLocale(context.Background(), "WELCOME_EMAIL", "EMAIL", "")
+	if err == nil {
+		t.Error("Expected error for empty locale, got nil")
+	}
+}
+
 func TestTemplate_GetMasterList_Success(t *testing.T) {
 	expectedMasterList := []MasterList{
 		{
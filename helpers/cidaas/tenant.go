@@ -0,0 +1,67 @@
+package cidaas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
+)
+
+type TenantInfoModel struct {
+	ID             string `json:"_id,omitempty"`
+	TenantKey      string `json:"tenant_key,omitempty"`
+	TenantName     string `json:"tenant_name,omitempty"`
+	CustomDomain   string `json:"custom_domain,omitempty"`
+	DefaultLocale  string `json:"default_locale,omitempty"`
+	LegalEntity    string `json:"legal_entity,omitempty"`
+	CompanyName    string `json:"company_name,omitempty"`
+	CompanyAddress string `json:"company_address,omitempty"`
+	CompanyWebsite string `json:"company_website,omitempty"`
+	CreatedTime    string `json:"createdTime,omitempty"`
+	UpdatedTime    string `json:"updatedTime,omitempty"`
+}
+
+type TenantInfoResponse struct {
+	Success bool            `json:"success,omitempty"`
+	Status  int             `json:"status,omitempty"`
+	Data    TenantInfoModel `json:"data,omitempty"`
+}
+
+type Tenant struct {
+	ClientConfig
+}
+
+func NewTenant(clientConfig ClientConfig) *Tenant {
+	return &Tenant{clientConfig}
+}
+
+const tenantInfoEndpoint = "tenant-srv/tenantinfo"
+
+func (t *Tenant) Get(ctx context.Context) (*TenantInfoResponse, error) {
+	res, err := t.makeRequest(ctx, http.MethodGet, tenantInfoEndpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant info: %w", err)
+	}
+	defer res.Body.Close()
+
+	var response TenantInfoResponse
+	if err = util.ProcessResponse(res, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (t *Tenant) Update(ctx context.Context, tenant TenantInfoModel) (*TenantInfoResponse, error) {
+	res, err := t.makeRequest(ctx, http.MethodPut, tenantInfoEndpoint, tenant)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update tenant info: %w", err)
+	}
+	defer res.Body.Close()
+
+	var response TenantInfoResponse
+	if err = util.ProcessResponse(res, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
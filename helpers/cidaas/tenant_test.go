@@ -0,0 +1,121 @@
+// helpers/cidaas/tenant_test.go
+package cidaas
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNewTenant(t *testing.T) {
+	config := NewTestClientConfig("http://test.com")
+
+	tenant := NewTenant(config)
+
+	if tenant == nil {
+		t.Fatal("Expected tenant instance, got nil")
+	}
+
+	if tenant.BaseURL != config.BaseURL {
+		t.Errorf("Expected BaseURL %s, got %s", config.BaseURL, tenant.BaseURL)
+	}
+}
+
+func TestTenant_Get_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET method, got %s", r.Method)
+		}
+
+		if !strings.HasSuffix(r.URL.Path, "tenant-srv/tenantinfo") {
+			t.Errorf("Expected tenant-srv/tenantinfo endpoint, got %s", r.URL.Path)
+		}
+
+		response := TenantInfoResponse{
+			Success: true,
+			Status:  200,
+			Data: TenantInfoModel{
+				TenantKey:     "sample-tenant",
+				TenantName:    "Sample Tenant",
+				DefaultLocale: "en-US",
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	tenant := NewTenant(NewTestClientConfig(server.URL))
+
+	result, err := tenant.Get(context.Background())
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+
+	if result.Data.TenantKey != "sample-tenant" {
+		t.Errorf("Expected TenantKey sample-tenant, got %s", result.Data.TenantKey)
+	}
+
+	if result.Data.DefaultLocale != "en-US" {
+		t.Errorf("Expected DefaultLocale en-US, got %s", result.Data.DefaultLocale)
+	}
+}
+
+func TestTenant_Get_ServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error": "unauthorized"}`))
+	}))
+	defer server.Close()
+
+	tenant := NewTenant(NewTestClientConfig(server.URL))
+
+	_, err := tenant.Get(context.Background())
+	if err == nil {
+		t.Fatal("Expected error for unauthorized request, got nil")
+	}
+
+	if !strings.Contains(err.Error(), "failed to get tenant info") {
+		t.Errorf("Expected 'failed to get tenant info' in error, got %s", err.Error())
+	}
+}
+
+func TestTenant_Update_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT method, got %s", r.Method)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var received TenantInfoModel
+		json.Unmarshal(body, &received)
+
+		if received.TenantName != "Updated Tenant" {
+			t.Errorf("Expected TenantName Updated Tenant, got %s", received.TenantName)
+		}
+
+		response := TenantInfoResponse{
+			Success: true,
+			Status:  200,
+			Data:    received,
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	tenant := NewTenant(NewTestClientConfig(server.URL))
+
+	result, err := tenant.Update(context.Background(), TenantInfoModel{TenantName: "Updated Tenant", CompanyName: "Widas ID GmbH"})
+	if err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	if result.Data.CompanyName != "Widas ID GmbH" {
+		t.Errorf("Expected CompanyName Widas ID GmbH, got %s", result.Data.CompanyName)
+	}
+}
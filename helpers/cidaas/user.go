@@ -0,0 +1,106 @@
+package cidaas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
+)
+
+var AllowedUserStatus = []string{"VERIFIED", "PENDING", "DECLINED", "COMPROMISED"}
+
+type UserModel struct {
+	Sub           string            `json:"sub,omitempty"`
+	Email         string            `json:"email,omitempty"`
+	MobileNumber  string            `json:"mobile_number,omitempty"`
+	GivenName     string            `json:"given_name,omitempty"`
+	FamilyName    string            `json:"family_name,omitempty"`
+	Password      string            `json:"password,omitempty"`
+	PasswordEcho  string            `json:"password_echo,omitempty"`
+	Provider      string            `json:"provider,omitempty"`
+	UserStatus    string            `json:"user_status,omitempty"`
+	CustomFields  map[string]string `json:"customFields,omitempty"`
+	EmailVerified bool              `json:"email_verified,omitempty"`
+	CreatedTime   string            `json:"createdTime,omitempty"`
+	UpdatedTime   string            `json:"updatedTime,omitempty"`
+}
+
+type UserResponse struct {
+	Success bool      `json:"success,omitempty"`
+	Status  int       `json:"status,omitempty"`
+	Data    UserModel `json:"data,omitempty"`
+}
+
+type User struct {
+	ClientConfig
+}
+
+func NewUser(clientConfig ClientConfig) *User {
+	return &User{clientConfig}
+}
+
+const userEndpoint = "users-srv/user"
+
+func (u *User) Create(ctx context.Context, user UserModel) (*UserResponse, error) {
+	res, err := u.makeRequest(ctx, http.MethodPost, userEndpoint, user)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+	defer res.Body.Close()
+
+	var response UserResponse
+	if err = util.ProcessResponse(res, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (u *User) Get(ctx context.Context, sub string) (*UserResponse, error) {
+	if sub == "" {
+		return nil, fmt.Errorf("sub cannot be empty")
+	}
+	endpoint := fmt.Sprintf("%s/%s", userEndpoint, sub)
+	res, err := u.makeRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	defer res.Body.Close()
+
+	var response UserResponse
+	if err = util.ProcessResponse(res, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (u *User) Update(ctx context.Context, user UserModel) (*UserResponse, error) {
+	if user.Sub == "" {
+		return nil, fmt.Errorf("sub cannot be empty")
+	}
+	endpoint := fmt.Sprintf("%s/%s", userEndpoint, user.Sub)
+	res, err := u.makeRequest(ctx, http.MethodPut, endpoint, user)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+	defer res.Body.Close()
+
+	var response UserResponse
+	if err = util.ProcessResponse(res, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (u *User) Delete(ctx context.Context, sub string) error {
+	if sub == "" {
+		return fmt.Errorf("sub cannot be empty")
+	}
+	endpoint := fmt.Sprintf("%s/%s", userEndpoint, sub)
+	res, err := u.makeRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+	defer res.Body.Close()
+	return nil
+}
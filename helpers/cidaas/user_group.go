@@ -40,6 +40,20 @@ type SubGroupResponse struct {
 	} `json:"data,omitempty"`
 }
 
+type GroupMemberData struct {
+	Sub         string   `json:"sub,omitempty"`
+	GroupID     string   `json:"groupId,omitempty"`
+	Roles       []string `json:"roles"`
+	CreatedTime string   `json:"createdTime,omitempty"`
+	UpdatedTime string   `json:"updatedTime,omitempty"`
+}
+
+type GroupMemberResponse struct {
+	Success bool            `json:"success,omitempty"`
+	Status  int             `json:"status,omitempty"`
+	Data    GroupMemberData `json:"data,omitempty"`
+}
+
 type UserGroup struct {
 	ClientConfig
 }
@@ -50,6 +64,9 @@ func NewUserGroup(clientConfig ClientConfig) *UserGroup {
 
 const userGroupsEndpoint = "groups-srv/usergroups"
 
+// RootUserGroupID is the parent of the top level user groups. It is created by cidaas with the tenant.
+const RootUserGroupID = "root"
+
 func (c *UserGroup) Create(ctx context.Context, ug UserGroupData) (*UserGroupResponse, error) {
 	res, err := c.makeRequest(ctx, http.MethodPost, userGroupsEndpoint, ug)
 	if err != nil {
@@ -131,3 +148,91 @@ func (c *UserGroup) GetSubGroups(ctx context.Context, parentID string) ([]UserGr
 	}
 	return response.Data.Groups, nil
 }
+
+// GetAll returns all user groups of the tenant. The tree is walked breadth first
+// starting from the sub groups of the root group, which itself is not returned.
+func (c *UserGroup) GetAll(ctx context.Context) ([]UserGroupData, error) {
+	var groups []UserGroupData
+	parents := []string{RootUserGroupID}
+	for len(parents) > 0 {
+		parentID := parents[0]
+		parents = parents[1:]
+
+		subGroups, err := c.GetSubGroups(ctx, parentID)
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range subGroups {
+			parents = append(parents, group.GroupID)
+			groups = append(groups, group)
+		}
+	}
+	return groups, nil
+}
+
+func (c *UserGroup) AddMember(ctx context.Context, member GroupMemberData) (*GroupMemberResponse, error) {
+	if member.GroupID == "" {
+		return nil, fmt.Errorf("groupID cannot be empty")
+	}
+	endpoint := fmt.Sprintf("%s/%s/members", userGroupsEndpoint, member.GroupID)
+	res, err := c.makeRequest(ctx, http.MethodPost, endpoint, member)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add group member: %w", err)
+	}
+	defer res.Body.Close()
+
+	var response GroupMemberResponse
+	if err = util.ProcessResponse(res, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (c *UserGroup) GetMember(ctx context.Context, groupID, sub string) (*GroupMemberResponse, error) {
+	if groupID == "" || sub == "" {
+		return nil, fmt.Errorf("groupID and sub cannot be empty")
+	}
+	endpoint := fmt.Sprintf("%s/%s/members/%s", userGroupsEndpoint, groupID, sub)
+	res, err := c.makeRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group member: %w", err)
+	}
+	defer res.Body.Close()
+
+	var response GroupMemberResponse
+	if err = util.ProcessResponse(res, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (c *UserGroup) UpdateMember(ctx context.Context, member GroupMemberData) (*GroupMemberResponse, error) {
+	if member.GroupID == "" || member.Sub == "" {
+		return nil, fmt.Errorf("groupID and sub cannot be empty")
+	}
+	endpoint := fmt.Sprintf("%s/%s/members/%s", userGroupsEndpoint, member.GroupID, member.Sub)
+	res, err := c.makeRequest(ctx, http.MethodPut, endpoint, member)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update group member: %w", err)
+	}
+	defer res.Body.Close()
+
+	var response GroupMemberResponse
+	if err = util.ProcessResponse(res, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (c *UserGroup) RemoveMember(ctx context.Context, groupID, sub string) error {
+	if groupID == "" || sub == "" {
+		return fmt.Errorf("groupID and sub cannot be empty")
+	}
+	endpoint := fmt.Sprintf("%s/%s/members/%s", userGroupsEndpoint, groupID, sub)
+	res, err := c.makeRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to remove group member: %w", err)
+	}
+	defer res.Body.Close()
+	return nil
+}
@@ -478,6 +478,46 @@ func TestUserGroup_GetSubGroups_NoContent(t *testing.T) {
 	}
 }
 
+func TestUserGroup_GetAll_Success(t *testing.T) {
+	subGroups := map[string]string{
+		RootUserGroupID: `[{"groupId":"sales","parentId":"root"},{"groupId":"support","parentId":"root"}]`,
+		"sales":         `[{"groupId":"sales-eu","parentId":"sales"}]`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]string
+		json.NewDecoder(r.Body).Decode(&payload)
+
+		groups, ok := subGroups[payload["parentId"]]
+		if !ok {
+			groups = "[]"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"success":true,"status":200,"data":{"groups":` + groups + `}}`))
+	}))
+	defer server.Close()
+
+	config := ClientConfig{
+		BaseURL:     server.URL,
+		AccessToken: "test-token",
+	}
+	userGroup := NewUserGroup(config)
+
+	result, err := userGroup.GetAll(context.Background())
+
+	if err != nil {
+		t.Fatalf("GetAll failed: %v", err)
+	}
+
+	var groupIDs []string
+	for _, group := range result {
+		groupIDs = append(groupIDs, group.GroupID)
+	}
+	if strings.Join(groupIDs, ",") != "sales,support,sales-eu" {
+		t.Errorf("Expected groups sales,support,sales-eu, got %v", groupIDs)
+	}
+}
+
 func TestUserGroup_GetSubGroups_InvalidJSON(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -576,3 +616,120 @@ func TestUserGroup_ContextCancellation(t *testing.T) {
 		t.Error("Expected context cancellation error, got nil")
 	}
 }
+
+func TestUserGroup_AddMember_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST method, got %s", r.Method)
+		}
+
+		if !strings.HasSuffix(r.URL.Path, "groups-srv/usergroups/developers/members") {
+			t.Errorf("Expected members endpoint, got %s", r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var received GroupMemberData
+		json.Unmarshal(body, &received)
+
+		if received.Sub != "user-sub" {
+			t.Errorf("Expected Sub user-sub, got %s", received.Sub)
+		}
+
+		response := GroupMemberResponse{
+			Success: true,
+			Status:  200,
+			Data:    received,
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	userGroup := NewUserGroup(NewTestClientConfig(server.URL))
+
+	result, err := userGroup.AddMember(context.Background(), GroupMemberData{
+		GroupID: "developers",
+		Sub:     "user-sub",
+		Roles:   []string{"ADMIN"},
+	})
+	if err != nil {
+		t.Fatalf("AddMember failed: %v", err)
+	}
+
+	if len(result.Data.Roles) != 1 || result.Data.Roles[0] != "ADMIN" {
+		t.Errorf("Expected Roles [ADMIN], got %v", result.Data.Roles)
+	}
+}
+
+func TestUserGroup_GetMember_EmptySub(t *testing.T) {
+	userGroup := NewUserGroup(ClientConfig{})
+
+	_, err := userGroup.GetMember(context.Background(), "developers", "")
+	if err == nil {
+		t.Fatal("Expected error for empty sub, got nil")
+	}
+
+	if err.Error() != "groupID and sub cannot be empty" {
+		t.Errorf("Expected 'groupID and sub cannot be empty', got %s", err.Error())
+	}
+}
+
+func TestUserGroup_UpdateMember_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT method, got %s", r.Method)
+		}
+
+		if !strings.HasSuffix(r.URL.Path, "groups-srv/usergroups/developers/members/user-sub") {
+			t.Errorf("Expected member endpoint, got %s", r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var received GroupMemberData
+		json.Unmarshal(body, &received)
+
+		response := GroupMemberResponse{
+			Success: true,
+			Status:  200,
+			Data:    received,
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	userGroup := NewUserGroup(NewTestClientConfig(server.URL))
+
+	result, err := userGroup.UpdateMember(context.Background(), GroupMemberData{
+		GroupID: "developers",
+		Sub:     "user-sub",
+		Roles:   []string{"MEMBER"},
+	})
+	if err != nil {
+		t.Fatalf("UpdateMember failed: %v", err)
+	}
+
+	if result.Data.Roles[0] != "MEMBER" {
+		t.Errorf("Expected Roles [MEMBER], got %v", result.Data.Roles)
+	}
+}
+
+func TestUserGroup_RemoveMember_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE method, got %s", r.Method)
+		}
+
+		if !strings.HasSuffix(r.URL.Path, "groups-srv/usergroups/developers/members/user-sub") {
+			t.Errorf("Expected member endpoint, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	userGroup := NewUserGroup(NewTestClientConfig(server.URL))
+
+	if err := userGroup.RemoveMember(context.Background(), "developers", "user-sub"); err != nil {
+		t.Fatalf("RemoveMember failed: %v", err)
+	}
+}
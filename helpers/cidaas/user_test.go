@@ -0,0 +1,161 @@
+// helpers/cidaas/user_test.go
+package cidaas
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNewUser(t *testing.T) {
+	config := NewTestClientConfig("http://test.com")
+
+	user := NewUser(config)
+
+	if user == nil {
+		t.Fatal("Expected user instance, got nil")
+	}
+
+	if user.BaseURL != config.BaseURL {
+		t.Errorf("Expected BaseURL %s, got %s", config.BaseURL, user.BaseURL)
+	}
+}
+
+func TestUser_Create_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST method, got %s", r.Method)
+		}
+
+		if !strings.HasSuffix(r.URL.Path, "users-srv/user") {
+			t.Errorf("Expected users-srv/user endpoint, got %s", r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var received UserModel
+		json.Unmarshal(body, &received)
+
+		if received.Password != "Secret@123" || received.PasswordEcho != "Secret@123" {
+			t.Error("Expected password and password_echo to be sent")
+		}
+
+		received.Sub = "user-sub"
+		received.Password = ""
+		received.PasswordEcho = ""
+		response := UserResponse{
+			Success: true,
+			Status:  200,
+			Data:    received,
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	user := NewUser(NewTestClientConfig(server.URL))
+
+	result, err := user.Create(context.Background(), UserModel{
+		Email:        "test@example.com",
+		Password:     "Secret@123",
+		PasswordEcho: "Secret@123",
+	})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	if result.Data.Sub != "user-sub" {
+		t.Errorf("Expected Sub user-sub, got %s", result.Data.Sub)
+	}
+}
+
+func TestUser_Get_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET method, got %s", r.Method)
+		}
+
+		if !strings.HasSuffix(r.URL.Path, "users-srv/user/user-sub") {
+			t.Errorf("Expected sub in URL path, got %s", r.URL.Path)
+		}
+
+		response := UserResponse{
+			Success: true,
+			Status:  200,
+			Data: UserModel{
+				Sub:          "user-sub",
+				Email:        "test@example.com",
+				CustomFields: map[string]string{"department": "IT"},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	user := NewUser(NewTestClientConfig(server.URL))
+
+	result, err := user.Get(context.Background(), "user-sub")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+
+	if result.Data.CustomFields["department"] != "IT" {
+		t.Errorf("Expected custom field department IT, got %v", result.Data.CustomFields)
+	}
+}
+
+func TestUser_Get_EmptySub(t *testing.T) {
+	user := NewUser(ClientConfig{})
+
+	_, err := user.Get(context.Background(), "")
+	if err == nil {
+		t.Fatal("Expected error for empty sub, got nil")
+	}
+
+	if err.Error() != "sub cannot be empty" {
+		t.Errorf("Expected 'sub cannot be empty', got %s", err.Error())
+	}
+}
+
+func TestUser_Update_ServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error": "invalid user"}`))
+	}))
+	defer server.Close()
+
+	user := NewUser(NewTestClientConfig(server.URL))
+
+	_, err := user.Update(context.Background(), UserModel{Sub: "user-sub"})
+	if err == nil {
+		t.Fatal("Expected error for server error, got nil")
+	}
+
+	if !strings.Contains(err.Error(), "failed to update user") {
+		t.Errorf("Expected 'failed to update user' in error, got %s", err.Error())
+	}
+}
+
+func TestUser_Delete_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE method, got %s", r.Method)
+		}
+
+		if !strings.HasSuffix(r.URL.Path, "users-srv/user/user-sub") {
+			t.Errorf("Expected sub in URL path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	user := NewUser(NewTestClientConfig(server.URL))
+
+	if err := user.Delete(context.Background(), "user-sub"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+}
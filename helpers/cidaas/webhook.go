@@ -61,6 +61,12 @@ type AuthDetails struct {
 	ClientID string `json:"client_id,omitempty"`
 }
 
+type AllWebhookResponse struct {
+	Success bool           `json:"success,omitempty"`
+	Status  int            `json:"status,omitempty"`
+	Data    []WebhookModel `json:"data,omitempty"`
+}
+
 type WebhookResponse struct {
 	Success bool         `json:"success,omitempty"`
 	Status  int          `json:"status,omitempty"`
@@ -121,3 +127,17 @@ func (w *Webhook) Delete(ctx context.Context, id string) error {
 	defer res.Body.Close()
 	return nil
 }
+
+func (w *Webhook) GetAll(ctx context.Context) ([]WebhookModel, error) {
+	res, err := w.makeRequest(ctx, http.MethodGet, webhookEndpoint+"/list", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	defer res.Body.Close()
+
+	var response AllWebhookResponse
+	if err = util.ProcessResponse(res, &response); err != nil {
+		return nil, err
+	}
+	return response.Data, nil
+}
@@ -297,6 +297,59 @@ func TestWebhook_Get_NotFound(t *testing.T) {
 	}
 }
 
+func TestWebhook_GetAll_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET method, got %s", r.Method)
+		}
+		if r.URL.Path != "/webhook-srv/webhook/list" {
+			t.Errorf("Expected /webhook-srv/webhook/list, got %s", r.URL.Path)
+		}
+
+		response := AllWebhookResponse{
+			Success: true,
+			Status:  200,
+			Data: []WebhookModel{
+				{ID: "webhook-1", AuthType: "APIKEY", URL: "https://example.com/one", Events: []string{"LOGIN_WITH_CIDAAS"}},
+				{ID: "webhook-2", AuthType: "TOTP", URL: "https://example.com/two", Events: []string{"LOGOUT"}},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	config := NewTestClientConfig(server.URL)
+	webhook := NewWebhook(config)
+
+	webhooks, err := webhook.GetAll(context.Background())
+	if err != nil {
+		t.Fatalf("GetAll failed: %v", err)
+	}
+	if len(webhooks) != 2 {
+		t.Fatalf("Expected 2 webhooks, got %d", len(webhooks))
+	}
+	if webhooks[1].ID != "webhook-2" || webhooks[1].URL != "https://example.com/two" {
+		t.Errorf("Unexpected webhook %+v", webhooks[1])
+	}
+}
+
+func TestWebhook_GetAll_ServerError(t *testing.T) {
+	server := NewMockServer(http.StatusInternalServerError, `{"error": "internal server error"}`)
+	defer server.Close()
+
+	config := NewTestClientConfig(server.URL)
+	webhook := NewWebhook(config)
+
+	_, err := webhook.GetAll(context.Background())
+	if err == nil {
+		t.Fatal("Expected error for server error, got nil")
+	}
+	if !strings.Contains(err.Error(), "failed to list webhooks") {
+		t.Errorf("Expected 'failed to list webhooks' in error, got %s", err.Error())
+	}
+}
+
 func TestWebhook_Delete_Success(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Verify request method and endpoint
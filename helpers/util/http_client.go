@@ -9,6 +9,10 @@ import (
 	"net/http"
 )
 
+// Transport sends the requests of all HTTP clients. The acceptance tests replace it to record and replay
+// the requests to cidaas.
+var Transport = http.DefaultTransport
+
 // HTTPClient provides a configurable HTTP client with authentication and error handling.
 // It supports common HTTP methods and automatic JSON marshaling.
 type HTTPClient struct {
@@ -58,7 +62,7 @@ func (h *HTTPClient) MakeRequest(ctx context.Context, requestBody interface{}) (
 		reqBodyByte = bytes.NewBuffer(bodyByte)
 	}
 
-	client := http.DefaultClient
+	client := &http.Client{Transport: Transport}
 	req, err := http.NewRequestWithContext(ctx, h.HTTPMethod, h.URL, reqBodyByte)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create HTTP request, %w", err)
@@ -29,8 +29,10 @@ type CustomProvider struct {
 	Domains      types.Set    `tfsdk:"domains"`
 }
 
-var customProviderFilter = FilterConfig{
+// CustomProviderFilter is the filter of the custom provider data source and list resource.
+var CustomProviderFilter = FilterConfig{
 	"provider_name": {TypeFunc: FilterTypeString},
+	"display_name":  {TypeFunc: FilterTypeString},
 	"standard_type": {TypeFunc: FilterTypeString},
 }
 
@@ -55,7 +57,7 @@ var customProviderSchema = schema.Schema{
 		},
 	},
 	Blocks: map[string]schema.Block{
-		"filter": customProviderFilter.Schema(),
+		"filter": CustomProviderFilter.Schema(),
 		"custom_provider": schema.ListNestedBlock{
 			Description: "The returned list of custom providers.",
 			NestedObject: schema.NestedBlockObject{
@@ -99,7 +101,7 @@ func (d *CustomProviderDataSource) Read(
 	}
 
 	data.ID = types.StringValue(uuid.New().String())
-	result, diag := customProviderFilter.GetAndFilter(ctx, d.Client, data.Filters, listCustomProviders)
+	result, diag := CustomProviderFilter.GetAndFilter(ctx, d.Client, data.Filters, listCustomProviders)
 	if diag != nil {
 		tflog.Error(ctx, "failed to filter custom_provider data", util.H{
 			"error":  diag.Summary(),
@@ -2,7 +2,6 @@ package datasources_test
 
 import (
 	"fmt"
-	"os"
 	"testing"
 
 	acctest "github.com/Cidaas/terraform-provider-cidaas/internal/test"
@@ -24,7 +23,7 @@ func TestAccDataSourceCustomProvider_basic(t *testing.T) {
 				}
 				data "cidaas_custom_provider" "sample" {
 				}
-				`, os.Getenv("BASE_URL")),
+				`, acctest.GetBaseURL()),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceName, "custom_provider.#"),
 					resource.TestCheckResourceAttrSet(resourceName, "custom_provider.0.provider_name"),
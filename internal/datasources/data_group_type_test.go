@@ -2,7 +2,6 @@ package datasources_test
 
 import (
 	"fmt"
-	"os"
 	"testing"
 
 	acctest "github.com/Cidaas/terraform-provider-cidaas/internal/test"
@@ -24,7 +23,7 @@ func TestAccGroupTypeDataSource_Basic(t *testing.T) {
 				}
 				data "cidaas_group_type" "sample" {
 				}
-				`, os.Getenv("BASE_URL")),
+				`, acctest.GetBaseURL()),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceName, "group_type.#"),
 					resource.TestCheckResourceAttrSet(resourceName, "group_type.0.id"),
@@ -56,7 +55,7 @@ func TestAccGroupTypeDataSource_RoleModeFilter(t *testing.T) {
 						values = ["allowed_roles"]
 					}
 				}
-				`, os.Getenv("BASE_URL")),
+				`, acctest.GetBaseURL()),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceName, "group_type.#"),
 					resource.TestCheckResourceAttr(resourceName, "group_type.0.role_mode", "allowed_roles"),
@@ -36,7 +36,8 @@ type RegistrationField struct {
 	Order         types.Int64  `tfsdk:"order"`
 }
 
-var registrationFieldsFilter = FilterConfig{
+// RegistrationFieldFilter is the filter of the registration field data source and list resource.
+var RegistrationFieldFilter = FilterConfig{
 	"parent_group_id": {TypeFunc: FilterTypeString},
 	"field_type":      {TypeFunc: FilterTypeString},
 	"data_type":       {TypeFunc: FilterTypeString},
@@ -105,7 +106,7 @@ var fieldDataSourceSchema = schema.Schema{
 		},
 	},
 	Blocks: map[string]schema.Block{
-		"filter": registrationFieldsFilter.Schema(),
+		"filter": RegistrationFieldFilter.Schema(),
 		"registration_field": schema.ListNestedBlock{
 			Description: "The returned list of registration fields.",
 			NestedObject: schema.NestedBlockObject{
@@ -138,7 +139,7 @@ func (d *RegistrationFieldsDataSource) Read(ctx context.Context, req datasource.
 	}
 
 	data.ID = types.StringValue(uuid.New().String())
-	result, diag := registrationFieldsFilter.GetAndFilter(ctx, d.Client, data.Filters, listRegistrationFieldss)
+	result, diag := RegistrationFieldFilter.GetAndFilter(ctx, d.Client, data.Filters, listRegistrationFieldss)
 	if diag != nil {
 		tflog.Error(ctx, "failed to filter registration_field data", util.H{
 			"error":  diag.Summary(),
@@ -2,7 +2,6 @@ package datasources_test
 
 import (
 	"fmt"
-	"os"
 	"testing"
 
 	acctest "github.com/Cidaas/terraform-provider-cidaas/internal/test"
@@ -24,7 +23,7 @@ func TestAccRegFieldDataSource_Basic(t *testing.T) {
 				}
 				data "cidaas_registration_field" "sample" {
 				}
-				`, os.Getenv("BASE_URL")),
+				`, acctest.GetBaseURL()),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceName, "registration_field.#"),
 					resource.TestCheckResourceAttrSet(resourceName, "registration_field.0.id"),
@@ -63,7 +62,7 @@ func TestAccRegFieldDataSource_FieldTypeFilter(t *testing.T) {
 						values = ["CUSTOM"]
 					}
 				}
-				`, os.Getenv("BASE_URL")),
+				`, acctest.GetBaseURL()),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "registration_field.0.field_type", "CUSTOM"),
 				),
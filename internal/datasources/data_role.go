@@ -29,7 +29,8 @@ type Role struct {
 	Role        types.String `tfsdk:"role"`
 }
 
-var roleFilter = FilterConfig{
+// RoleFilter is the filter of the role data source and list resource.
+var RoleFilter = FilterConfig{
 	"role": {TypeFunc: FilterTypeString},
 	"name": {TypeFunc: FilterTypeString},
 }
@@ -55,7 +56,7 @@ var roleDataSourceSchema = schema.Schema{
 		},
 	},
 	Blocks: map[string]schema.Block{
-		"filter": roleFilter.Schema(),
+		"filter": RoleFilter.Schema(),
 		"role": schema.ListNestedBlock{
 			Description: "The returned list of roles.",
 			NestedObject: schema.NestedBlockObject{
@@ -94,7 +95,7 @@ func (d *RoleDataSource) Read(
 	}
 
 	data.ID = types.StringValue(uuid.New().String())
-	result, diag := roleFilter.GetAndFilter(ctx, d.Client, data.Filters, listRoles)
+	result, diag := RoleFilter.GetAndFilter(ctx, d.Client, data.Filters, listRoles)
 	if diag != nil {
 		tflog.Error(ctx, "failed to filter role data", util.H{
 			"error":  diag.Summary(),
@@ -2,7 +2,6 @@ package datasources_test
 
 import (
 	"fmt"
-	"os"
 	"testing"
 
 	acctest "github.com/Cidaas/terraform-provider-cidaas/internal/test"
@@ -24,7 +23,7 @@ func TestAccRoleDataSource_Basic(t *testing.T) {
 				}
 				data "cidaas_role" "sample" {
 				}
-				`, os.Getenv("BASE_URL")),
+				`, acctest.GetBaseURL()),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceName, "role.#"),
 					resource.TestCheckResourceAttrSet(resourceName, "role.0.role"),
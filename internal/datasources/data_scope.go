@@ -39,7 +39,8 @@ type LocalDescription struct {
 	Description types.String `tfsdk:"description"`
 }
 
-var scopeFilter = FilterConfig{
+// ScopeFilter is the filter of the scope data source and list resource.
+var ScopeFilter = FilterConfig{
 	"scope_key":             {TypeFunc: FilterTypeString},
 	"security_level":        {TypeFunc: FilterTypeString},
 	"group_name":            {TypeFunc: FilterTypeString},
@@ -103,7 +104,7 @@ var scopesDataSourceSchema = schema.Schema{
 		},
 	},
 	Blocks: map[string]schema.Block{
-		"filter": scopeFilter.Schema(),
+		"filter": ScopeFilter.Schema(),
 		"scope": schema.ListNestedBlock{
 			Description: "The returned list of scopes.",
 			NestedObject: schema.NestedBlockObject{
@@ -140,7 +141,7 @@ func (d *ScopesDataSource) Read(
 	}
 
 	data.ID = types.StringValue(uuid.New().String())
-	result, diag := scopeFilter.GetAndFilter(ctx, d.Client, data.Filters, listScopes)
+	result, diag := ScopeFilter.GetAndFilter(ctx, d.Client, data.Filters, listScopes)
 	if diag != nil {
 		tflog.Error(ctx, "failed to filter scopes data", util.H{
 			"error":  diag.Summary(),
@@ -2,7 +2,6 @@ package datasources_test
 
 import (
 	"fmt"
-	"os"
 	"testing"
 
 	acctest "github.com/Cidaas/terraform-provider-cidaas/internal/test"
@@ -23,7 +22,7 @@ func TestAccScopeGroupDataSource_Basic(t *testing.T) {
 					base_url = "%s"
 				}
 				data "cidaas_scope_group" "sample" {}
-				`, os.Getenv("BASE_URL")),
+				`, acctest.GetBaseURL()),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceName, "scope_group.#"),
 					resource.TestCheckResourceAttrSet(resourceName, "scope_group.0.id"),
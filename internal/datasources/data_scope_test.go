@@ -2,7 +2,6 @@ package datasources_test
 
 import (
 	"fmt"
-	"os"
 	"testing"
 
 	acctest "github.com/Cidaas/terraform-provider-cidaas/internal/test"
@@ -23,7 +22,7 @@ func TestAccScopeDataSource_Basic(t *testing.T) {
 					base_url = "%s"
 				}
 				data "cidaas_scope" "sample" {}
-				`, os.Getenv("BASE_URL")),
+				`, acctest.GetBaseURL()),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceName, "scope.#"),
 					resource.TestCheckResourceAttrSet(resourceName, "scope.0.security_level"),
@@ -55,7 +54,7 @@ func TestAccScopeDataSource_SecurityLevelFilter(t *testing.T) {
 						values = ["PUBLIC"]
 					}
 				}
-				`, os.Getenv("BASE_URL")),
+				`, acctest.GetBaseURL()),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceName, "scope.#"),
 					resource.TestCheckResourceAttr(resourceName, "scope.0.security_level", "PUBLIC"),
@@ -33,11 +33,12 @@ type SocialProvider struct {
 	Scopes                types.Set    `tfsdk:"scopes"`
 }
 
-var socialProviderFilter = FilterConfig{
+// SocialProviderFilter is the filter of the social provider data source and list resource.
+var SocialProviderFilter = FilterConfig{
 	"name":                     {TypeFunc: FilterTypeString},
 	"provider_name":            {TypeFunc: FilterTypeString},
-	"enabled":                  {TypeFunc: FilterTypeString},
-	"enabled_for_admin_portal": {TypeFunc: FilterTypeString},
+	"enabled":                  {TypeFunc: FilterTypeBool},
+	"enabled_for_admin_portal": {TypeFunc: FilterTypeBool},
 }
 
 var socialProviderSchema = map[string]schema.Attribute{
@@ -87,7 +88,7 @@ var socialProviderDataSourceSchema = schema.Schema{
 		},
 	},
 	Blocks: map[string]schema.Block{
-		"filter": socialProviderFilter.Schema(),
+		"filter": SocialProviderFilter.Schema(),
 		"social_provider": schema.ListNestedBlock{
 			Description: "The returned list of social providers.",
 			NestedObject: schema.NestedBlockObject{
@@ -124,7 +125,7 @@ func (d *SocialProviderDataSource) Read(
 	}
 
 	data.ID = types.StringValue(uuid.New().String())
-	result, diag := socialProviderFilter.GetAndFilter(ctx, d.Client, data.Filters, listSocialProviders)
+	result, diag := SocialProviderFilter.GetAndFilter(ctx, d.Client, data.Filters, listSocialProviders)
 	if diag != nil {
 		tflog.Error(ctx, "failed to filter social_provider data", util.H{
 			"error":  diag.Summary(),
@@ -2,7 +2,6 @@ package datasources_test
 
 import (
 	"fmt"
-	"os"
 	"testing"
 
 	acctest "github.com/Cidaas/terraform-provider-cidaas/internal/test"
@@ -23,7 +22,7 @@ func TestAccSocialProviderDataSource_Basic(t *testing.T) {
 					base_url = "%s"
 				}
 				data "cidaas_social_provider" "sample" {}
-				`, os.Getenv("BASE_URL")),
+				`, acctest.GetBaseURL()),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceName, "social_provider.#"),
 					resource.TestCheckResourceAttrSet(resourceName, "social_provider.0.id"),
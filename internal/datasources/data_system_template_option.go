@@ -14,16 +14,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-const (
-	LINK    = "LINK"
-	CODE    = "CODE"
-	IVR     = "IVR"
-	EMAIL   = "EMAIL"
-	SMS     = "SMS"
-	PUSH    = "PUSH"
-	GENERAL = "GENERAL"
-)
-
 type SystemTemplateOptionsDataSource struct {
 	BaseDataSource
 }
@@ -242,12 +232,12 @@ func parseSystemTemplate(ml cidaas.MasterList) (r SystemTemplateOption) {
 
 	for _, t := range ml.TemplateTypes {
 		tt := t.TemplateType
-		th := TemaplateTagHandler{
-			TemplateData: TemplateData{
+		th := cidaas.TemplateTagHandler{
+			TemplateData: cidaas.TemplateData{
 				TemplateType: tt,
 			},
 		}
-		th.addSupportedTags()
+		th.AddSupportedTags()
 		var processingTypeobjectValues []attr.Value
 
 		if len(t.ProcessingTypes) > 0 {
@@ -273,15 +263,15 @@ func parseSystemTemplate(ml cidaas.MasterList) (r SystemTemplateOption) {
 					"supported_tags": types.ObjectValueMust(
 						supportedTagsAttrTypes,
 						map[string]attr.Value{
-							"required": util.SetValueOrNull(th.getTemplateTags(r.TemplateKey.ValueString()).Required),
-							"optional": util.SetValueOrNull(th.getTemplateTags(r.TemplateKey.ValueString()).Optional),
+							"required": util.SetValueOrNull(th.GetTemplateTags(r.TemplateKey.ValueString()).Required),
+							"optional": util.SetValueOrNull(th.GetTemplateTags(r.TemplateKey.ValueString()).Optional),
 						}),
 					"verification_types": types.ListValueMust(verificationTypes, verificationTypeobjectValues),
 				})
 				processingTypeobjectValues = append(processingTypeobjectValues, ptObjValue)
 			}
 		} else {
-			pt := GENERAL
+			pt := cidaas.GENERAL
 			th.TemplateData.ProcessingType = pt
 			var verificationTypeobjectValues []attr.Value
 
@@ -290,8 +280,8 @@ func parseSystemTemplate(ml cidaas.MasterList) (r SystemTemplateOption) {
 				"supported_tags": types.ObjectValueMust(
 					supportedTagsAttrTypes,
 					map[string]attr.Value{
-						"required": util.SetValueOrNull(th.getTemplateTags(r.TemplateKey.ValueString()).Required),
-						"optional": util.SetValueOrNull(th.getTemplateTags(r.TemplateKey.ValueString()).Optional),
+						"required": util.SetValueOrNull(th.GetTemplateTags(r.TemplateKey.ValueString()).Required),
+						"optional": util.SetValueOrNull(th.GetTemplateTags(r.TemplateKey.ValueString()).Optional),
 					}),
 				"verification_types": types.ListValueMust(verificationTypes, verificationTypeobjectValues),
 			})
@@ -307,173 +297,3 @@ func parseSystemTemplate(ml cidaas.MasterList) (r SystemTemplateOption) {
 	r.TemplateTypes = types.ListValueMust(templateTypes, templateTypeobjectValues)
 	return r
 }
-
-type TemplateTags struct {
-	Required []string
-	Optional []string
-}
-
-type TemplateData struct {
-	TemplateType   string
-	ProcessingType string
-}
-
-type TagsListPayload struct {
-	VerifyAccountEmailSMSLink   TemplateTags
-	VerifyAccountEmailSMSCode   TemplateTags
-	VerifyAccountIVRCode        TemplateTags
-	WelcomeUserEmailSMSLink     TemplateTags
-	WelcomeUserIVRLink          TemplateTags
-	InviteUserEmail             TemplateTags
-	ResetPasswordEmailLink      TemplateTags
-	ResetPasswordEmailCode      TemplateTags
-	ResetPasswordSMS            TemplateTags
-	ResetPasswordIVR            TemplateTags
-	AfterChangePasswordEmailSMS TemplateTags
-	AfterChangePasswordIVR      TemplateTags
-	UserCreatedEmailSMS         TemplateTags
-	VerifyUserEmailLink         TemplateTags
-	VerifyUserCodeGeneral       TemplateTags
-	VerifyUserSMSIVR            TemplateTags
-	VerifyUserPush              TemplateTags
-	NotifyCommunicationChange   TemplateTags
-}
-
-type TemaplateTagHandler struct {
-	TemplateData    TemplateData
-	TagsListPayload TagsListPayload
-}
-
-func (th *TemaplateTagHandler) getTemplateTags(text string) TemplateTags { //nolint:gocognit
-	var tags TemplateTags
-
-	switch text {
-	case "VERIFY_ACCOUNT":
-		if th.TemplateData.TemplateType == EMAIL || th.TemplateData.TemplateType == SMS {
-			if th.TemplateData.ProcessingType == LINK {
-				tags = th.TagsListPayload.VerifyAccountEmailSMSLink
-			} else if th.TemplateData.ProcessingType == CODE {
-				tags = th.TagsListPayload.VerifyAccountEmailSMSCode
-			}
-		} else if th.TemplateData.TemplateType == IVR {
-			tags = th.TagsListPayload.VerifyAccountIVRCode
-		}
-	case "WELCOME_USER":
-		if th.TemplateData.TemplateType == EMAIL || th.TemplateData.TemplateType == SMS {
-			tags = th.TagsListPayload.WelcomeUserEmailSMSLink
-		} else if th.TemplateData.TemplateType == IVR {
-			tags = th.TagsListPayload.WelcomeUserIVRLink
-		}
-	case "INVITE_USER":
-		tags = th.TagsListPayload.InviteUserEmail
-	case "RESET_PASSWORD":
-		if th.TemplateData.TemplateType == EMAIL {
-			if th.TemplateData.ProcessingType == LINK {
-				tags = th.TagsListPayload.ResetPasswordEmailLink
-			} else if th.TemplateData.ProcessingType == CODE {
-				tags = th.TagsListPayload.ResetPasswordEmailCode
-			}
-		} else if th.TemplateData.TemplateType == SMS {
-			tags = th.TagsListPayload.ResetPasswordSMS
-		} else if th.TemplateData.TemplateType == IVR {
-			tags = th.TagsListPayload.ResetPasswordIVR
-		}
-	case "CHANGE_PASSWORD", "AFTER_CHANGE_PASSWORD":
-		if th.TemplateData.TemplateType == EMAIL || th.TemplateData.TemplateType == SMS {
-			tags = th.TagsListPayload.AfterChangePasswordEmailSMS
-		} else if th.TemplateData.TemplateType == IVR {
-			tags = th.TagsListPayload.AfterChangePasswordIVR
-		}
-	case "NEW_DEVICE", "NEW_LOCATION", "NEW_NETWORK":
-		tags = TemplateTags{
-			Optional: []string{"{{name}}", "{{account_name}}}"},
-		}
-	case "USER_CREATED":
-		tags = th.TagsListPayload.UserCreatedEmailSMS
-	case "VERIFY_USER":
-		if th.TemplateData.TemplateType == EMAIL {
-			if th.TemplateData.ProcessingType == LINK {
-				tags = th.TagsListPayload.VerifyUserEmailLink
-			} else if th.TemplateData.ProcessingType == CODE || th.TemplateData.ProcessingType == GENERAL {
-				tags = th.TagsListPayload.VerifyUserCodeGeneral
-			}
-		} else if th.TemplateData.TemplateType == SMS || th.TemplateData.TemplateType == IVR {
-			tags = th.TagsListPayload.VerifyUserSMSIVR
-		} else if th.TemplateData.TemplateType == PUSH {
-			tags = th.TagsListPayload.VerifyUserPush
-		}
-	case "NOTIFY_COMMUNICATION_CHANGE":
-		tags = th.TagsListPayload.NotifyCommunicationChange
-	}
-	return tags
-}
-
-func (th *TemaplateTagHandler) addSupportedTags() {
-	th.TagsListPayload = TagsListPayload{
-		VerifyAccountEmailSMSLink: TemplateTags{
-			Required: []string{"{{{verify_link}}}"},
-			Optional: []string{"{{name}}", "{{account_name}}}"},
-		},
-		VerifyAccountEmailSMSCode: TemplateTags{
-			Required: []string{"{{code}}", "{{{verify_link}}}"},
-			Optional: []string{"{{name}}", "{{account_name}}}"},
-		},
-		VerifyAccountIVRCode: TemplateTags{
-			Required: []string{"{{code}}"},
-		},
-		WelcomeUserEmailSMSLink: TemplateTags{
-			Required: []string{"{{{login_link}}}"},
-			Optional: []string{"{{name}}", "{{account_name}}}"},
-		},
-		WelcomeUserIVRLink: TemplateTags{
-			Optional: []string{"{{name}}", "{{account_name}}}"},
-		},
-		InviteUserEmail: TemplateTags{
-			Required: []string{"{{{invite_link}}}"},
-			Optional: []string{"{{name}}", "{{account_name}}}", "{{{invited_by}}}"},
-		},
-		ResetPasswordEmailLink: TemplateTags{
-			Required: []string{"{{{reset_link}}}"},
-			Optional: []string{"{{name}}", "{{account_name}}}"},
-		},
-		ResetPasswordEmailCode: TemplateTags{
-			Required: []string{"{{code}}, {{{reset_link}}}"},
-			Optional: []string{"{{name}}", "{{account_name}}}"},
-		},
-		ResetPasswordSMS: TemplateTags{
-			Required: []string{"{{code}}"},
-			Optional: []string{"{{name}}", "{{account_name}}}"},
-		},
-		ResetPasswordIVR: TemplateTags{
-			Required: []string{"{{code}}"},
-		},
-		AfterChangePasswordEmailSMS: TemplateTags{
-			Required: []string{"{{{reset_link}}}"},
-			Optional: []string{"{{name}}", "{{account_name}}}"},
-		},
-		AfterChangePasswordIVR: TemplateTags{
-			Optional: []string{"{{name}}", "{{account_name}}}"},
-		},
-		UserCreatedEmailSMS: TemplateTags{
-			Optional: []string{"{{name}}", "{{account_name}}}", "{{user_name}}", "{{password}}", "{{{login_link}}}"},
-		},
-		VerifyUserEmailLink: TemplateTags{
-			Optional: []string{"{{name}}", "{{code}}}"},
-			Required: []string{"{{verify_link}}"},
-		},
-		VerifyUserCodeGeneral: TemplateTags{
-			Optional: []string{"{{name}}", "{{verify_link}}}"},
-			Required: []string{"{{code}}"},
-		},
-		VerifyUserSMSIVR: TemplateTags{
-			Optional: []string{"{{name}}"},
-			Required: []string{"{{code}}"},
-		},
-		VerifyUserPush: TemplateTags{
-			Required: []string{"{{address}}"},
-		},
-		NotifyCommunicationChange: TemplateTags{
-			Optional: []string{"{{name}}", "{{account_name}}}", "{{communication_medium_value}}"},
-		},
-	}
-}
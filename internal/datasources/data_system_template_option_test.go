@@ -2,7 +2,6 @@ package datasources_test
 
 import (
 	"fmt"
-	"os"
 	"testing"
 
 	acctest "github.com/Cidaas/terraform-provider-cidaas/internal/test"
@@ -23,7 +22,7 @@ func TestAccSysTemplateOptionDataSource_Basic(t *testing.T) {
 					base_url = "%s"
 				}
 				data "cidaas_system_template_option" "sample" {}
-				`, os.Getenv("BASE_URL")),
+				`, acctest.GetBaseURL()),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceName, "system_template_option.#"),
 					resource.TestCheckResourceAttrSet(resourceName, "system_template_option.0.template_key"),
@@ -55,7 +54,7 @@ func TestAccSysTemplateOptionDataSource_TemplateKeyFilter(t *testing.T) {
 						values = ["UN_REGISTER_USER_ALERT"]
 					}
 				}
-				`, os.Getenv("BASE_URL")),
+				`, acctest.GetBaseURL()),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceName, "system_template_option.#"),
 					resource.TestCheckResourceAttr(resourceName, "system_template_option.0.template_key", "UN_REGISTER_USER_ALERT"),
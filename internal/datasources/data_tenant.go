@@ -0,0 +1,124 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type TenantDataSource struct {
+	BaseDataSource
+}
+
+type TenantModel struct {
+	ID             types.String `tfsdk:"id"`
+	TenantKey      types.String `tfsdk:"tenant_key"`
+	TenantName     types.String `tfsdk:"tenant_name"`
+	CustomDomain   types.String `tfsdk:"custom_domain"`
+	DefaultLocale  types.String `tfsdk:"default_locale"`
+	LegalEntity    types.String `tfsdk:"legal_entity"`
+	CompanyName    types.String `tfsdk:"company_name"`
+	CompanyAddress types.String `tfsdk:"company_address"`
+	CompanyWebsite types.String `tfsdk:"company_website"`
+}
+
+var tenantDataSourceSchema = schema.Schema{
+	MarkdownDescription: fmt.Sprintf("The data source `%s` returns the information of the tenant the provider is configured for.", TENANT_DATASOURCE) +
+		"\n\n Ensure that the below scopes are assigned to the client with the specified `client_id`:" +
+		"\n- cidaas:tenant_read",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The data source's unique ID. It is the same as the tenant_key.",
+		},
+		"tenant_key": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique key of the tenant.",
+		},
+		"tenant_name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The display name of the tenant.",
+		},
+		"custom_domain": schema.StringAttribute{
+			Computed:    true,
+			Description: "The custom domain under which the tenant is reachable.",
+		},
+		"default_locale": schema.StringAttribute{
+			Computed:    true,
+			Description: "The default locale of the tenant.",
+		},
+		"legal_entity": schema.StringAttribute{
+			Computed:    true,
+			Description: "The legal entity operating the tenant.",
+		},
+		"company_name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the company.",
+		},
+		"company_address": schema.StringAttribute{
+			Computed:    true,
+			Description: "The address of the company.",
+		},
+		"company_website": schema.StringAttribute{
+			Computed:    true,
+			Description: "The URL of the company's website.",
+		},
+	},
+}
+
+func NewTenant() datasource.DataSource {
+	return &TenantDataSource{
+		BaseDataSource: NewBaseDataSource(
+			BaseDataSourceConfig{
+				Name:   TENANT_DATASOURCE,
+				Schema: &tenantDataSourceSchema,
+			},
+		),
+	}
+}
+
+func (d *TenantDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TenantModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get config data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	res, err := d.Client.Tenant.Get(ctx)
+	if err != nil {
+		tflog.Error(ctx, "failed to read tenant info via API", util.H{
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to read tenant info", util.FormatErrorMessage(err))
+		return
+	}
+
+	data.ID = util.StringValueOrNull(&res.Data.TenantKey)
+	data.TenantKey = util.StringValueOrNull(&res.Data.TenantKey)
+	data.TenantName = util.StringValueOrNull(&res.Data.TenantName)
+	data.CustomDomain = util.StringValueOrNull(&res.Data.CustomDomain)
+	data.DefaultLocale = util.StringValueOrNull(&res.Data.DefaultLocale)
+	data.LegalEntity = util.StringValueOrNull(&res.Data.LegalEntity)
+	data.CompanyName = util.StringValueOrNull(&res.Data.CompanyName)
+	data.CompanyAddress = util.StringValueOrNull(&res.Data.CompanyAddress)
+	data.CompanyWebsite = util.StringValueOrNull(&res.Data.CompanyWebsite)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "successfully read tenant data source")
+}
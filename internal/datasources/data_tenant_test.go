@@ -0,0 +1,34 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acctest "github.com/Cidaas/terraform-provider-cidaas/internal/test"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTenantDataSource_Basic(t *testing.T) {
+	t.Parallel()
+	resourceName := "data.cidaas_tenant.sample"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				provider "cidaas" {
+					base_url = "%s"
+				}
+				data "cidaas_tenant" "sample" {}
+				`, acctest.GetBaseURL()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "tenant_key"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "tenant_key"),
+				),
+			},
+		},
+	})
+}
@@ -23,6 +23,7 @@ const (
 	SCOPE_DATASOURCE           = "cidaas_scope"                  // nolint:stylecheck
 	SOCIAL_PROVIDER_DATASOURCE = "cidaas_social_provider"        // nolint:stylecheck
 	SYSTEM_TEMPLATE_DATASOURCE = "cidaas_system_template_option" // nolint:stylecheck
+	TENANT_DATASOURCE          = "cidaas_tenant"                 // nolint:stylecheck
 )
 
 type Parser[K, V any] func(K) V
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -77,6 +78,37 @@ func (f FilterConfig) Schema() schema.SetNestedBlock {
 	}
 }
 
+// ListSchema is the schema for the `filter` blocks of list resources
+func (f FilterConfig) ListSchema() listschema.ListNestedBlock {
+	return listschema.ListNestedBlock{
+		NestedObject: listschema.NestedBlockObject{
+			Attributes: map[string]listschema.Attribute{
+				"name": listschema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						f.validateFilterable(),
+					},
+					Description: "The name of the attribute to filter on.",
+				},
+				"values": listschema.ListAttribute{
+					Required:    true,
+					Description: "The value(s) to be used in the filter.",
+					ElementType: types.StringType,
+				},
+				"match_by": listschema.StringAttribute{
+					Optional:    true,
+					Description: "The type of comparison to use for this filter. Allowed values `exact`, `substring` and `regex`",
+					Validators: []validator.String{
+						stringvalidator.OneOfCaseInsensitive(
+							"exact", "substring", "regex",
+						),
+					},
+				},
+			},
+		},
+	}
+}
+
 // GetAndFilter will run all filter operations given the parameters
 func (f FilterConfig) GetAndFilter(ctx context.Context, client *cidaas.Client, filters []FilterModel, listFunc ListFunc) ([]any, diag.Diagnostic) {
 	elems, err := listFunc(ctx, client)
@@ -0,0 +1,208 @@
+// Package export generates Terraform configuration and import blocks for the resources of an existing cidaas tenant.
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type Config struct {
+	// BaseURL is written to the generated provider block.
+	BaseURL string
+	// OutDir is the directory the .tf files are written to. It is created if it does not exist.
+	OutDir string
+}
+
+// resourceBlock is a single resource of the tenant with the attributes of its configuration and its import id.
+type resourceBlock struct {
+	Type     string
+	Name     string
+	ImportID string
+	// Attributes writes the attributes of the resource. secret sets the attribute to a sensitive variable
+	// instead of writing the value of the tenant to the configuration.
+	Attributes func(body *hclwrite.Body, secret func(attribute string) hclwrite.Tokens)
+}
+
+// exporter lists the resources of one resource type.
+type exporter struct {
+	Type string
+	List func(ctx context.Context, client *cidaas.Client) ([]resourceBlock, error)
+}
+
+var exporters = []exporter{
+	{Type: "cidaas_role", List: roles},
+	{Type: "cidaas_scope", List: scopes},
+	{Type: "cidaas_scope_group", List: scopeGroups},
+	{Type: "cidaas_group_type", List: groupTypes},
+	{Type: "cidaas_user_group", List: userGroups},
+	{Type: "cidaas_app", List: apps},
+	{Type: "cidaas_webhook", List: webhooks},
+	{Type: "cidaas_template_set", List: templateSets},
+	{Type: "cidaas_registration_field", List: registrationFields},
+	{Type: "cidaas_consent", List: consents},
+	{Type: "cidaas_social_provider", List: socialProviders},
+	{Type: "cidaas_custom_provider", List: customProviders},
+}
+
+// Result is the summary of an export.
+type Result struct {
+	// Files are the paths of the written files.
+	Files []string
+	// Resources is the number of exported resources by resource type.
+	Resources map[string]int
+}
+
+// Run writes a <resource_type>.tf file with the resource and import blocks for every resource type,
+// a provider.tf and a variables.tf with the sensitive values which are not exported.
+// A resource type which fails to be listed is skipped, the errors are returned after all other types are written.
+func Run(ctx context.Context, client *cidaas.Client, cfg Config) (*Result, error) {
+	if err := os.MkdirAll(cfg.OutDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create output directory %s: %w", cfg.OutDir, err)
+	}
+
+	result := &Result{Resources: map[string]int{}}
+	var variables []string
+	var errs []error
+
+	for _, e := range exporters {
+		blocks, err := e.List(ctx, client)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to export %s: %w", e.Type, err))
+			continue
+		}
+		if len(blocks) == 0 {
+			continue
+		}
+		file, vars := generate(blocks)
+		path := filepath.Join(cfg.OutDir, e.Type+".tf")
+		if err := os.WriteFile(path, file.Bytes(), 0o600); err != nil {
+			errs = append(errs, fmt.Errorf("failed to write %s: %w", path, err))
+			continue
+		}
+		result.Files = append(result.Files, path)
+		result.Resources[e.Type] = len(blocks)
+		variables = append(variables, vars...)
+	}
+
+	path := filepath.Join(cfg.OutDir, "provider.tf")
+	if err := os.WriteFile(path, providerFile(cfg.BaseURL).Bytes(), 0o600); err != nil {
+		errs = append(errs, fmt.Errorf("failed to write %s: %w", path, err))
+	} else {
+		result.Files = append(result.Files, path)
+	}
+
+	if len(variables) > 0 {
+		path := filepath.Join(cfg.OutDir, "variables.tf")
+		if err := os.WriteFile(path, variablesFile(variables).Bytes(), 0o600); err != nil {
+			errs = append(errs, fmt.Errorf("failed to write %s: %w", path, err))
+		} else {
+			result.Files = append(result.Files, path)
+		}
+	}
+	return result, errors.Join(errs...)
+}
+
+// generate writes the resource blocks followed by their import blocks and returns the names of the sensitive variables.
+func generate(blocks []resourceBlock) (*hclwrite.File, []string) {
+	file := hclwrite.NewEmptyFile()
+	root := file.Body()
+	names := make([]string, len(blocks))
+	used := map[string]int{}
+	var variables []string
+
+	for i, b := range blocks {
+		names[i] = uniqueName(resourceName(b.Name), used)
+		name := names[i]
+
+		body := root.AppendNewBlock("resource", []string{b.Type, name}).Body()
+		b.Attributes(body, func(attribute string) hclwrite.Tokens {
+			variable := name + "_" + attribute
+			variables = append(variables, variable)
+			return hclwrite.TokensForTraversal(hcl.Traversal{
+				hcl.TraverseRoot{Name: "var"},
+				hcl.TraverseAttr{Name: variable},
+			})
+		})
+		root.AppendNewline()
+	}
+
+	for i, b := range blocks {
+		body := root.AppendNewBlock("import", nil).Body()
+		body.SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: b.Type},
+			hcl.TraverseAttr{Name: names[i]},
+		})
+		body.SetAttributeValue("id", cty.StringVal(b.ImportID))
+		if i < len(blocks)-1 {
+			root.AppendNewline()
+		}
+	}
+	return file, variables
+}
+
+func providerFile(baseURL string) *hclwrite.File {
+	file := hclwrite.NewEmptyFile()
+	root := file.Body()
+	providers := root.AppendNewBlock("terraform", nil).Body().AppendNewBlock("required_providers", nil).Body()
+	providers.SetAttributeValue("cidaas", cty.ObjectVal(map[string]cty.Value{
+		"source": cty.StringVal("Cidaas/cidaas"),
+	}))
+	root.AppendNewline()
+	root.AppendNewBlock("provider", []string{"cidaas"}).Body().SetAttributeValue("base_url", cty.StringVal(baseURL))
+	return file
+}
+
+func variablesFile(variables []string) *hclwrite.File {
+	sort.Strings(variables)
+	file := hclwrite.NewEmptyFile()
+	root := file.Body()
+	for i, variable := range variables {
+		body := root.AppendNewBlock("variable", []string{variable}).Body()
+		body.SetAttributeRaw("type", hclwrite.TokensForIdentifier("string"))
+		body.SetAttributeValue("sensitive", cty.True)
+		if i < len(variables)-1 {
+			root.AppendNewline()
+		}
+	}
+	return file
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// resourceName converts a key of cidaas into a valid Terraform resource name.
+func resourceName(key string) string {
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(key), "_"), "_")
+	if name == "" {
+		return "resource"
+	}
+	if name[0] >= '0' && name[0] <= '9' || name[0] == '-' {
+		name = "_" + name
+	}
+	return name
+}
+
+// uniqueName appends a counter to names which are already used in the same resource type.
+func uniqueName(name string, used map[string]int) string {
+	used[name]++
+	if used[name] == 1 {
+		return name
+	}
+	unique := fmt.Sprintf("%s_%d", name, used[name])
+	for used[unique] > 0 {
+		used[name]++
+		unique = fmt.Sprintf("%s_%d", name, used[name])
+	}
+	used[unique] = 1
+	return unique
+}
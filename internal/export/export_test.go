@@ -0,0 +1,193 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/hashicorp/hcl/v2/hclparse"
+)
+
+// tenantResponses are the list responses of the mock tenant by path.
+var tenantResponses = map[string]string{
+	"/groups-srv/graph/roles": `{"success":true,"data":[{"role":"admin","name":"Admin","description":"administrators"},{"role":"ADMIN"}]}`,
+	"/scopes-srv/scope/list": `{"success":true,"data":[{"scopeKey":"profile","securityLevel":"PUBLIC",` +
+		`"localeWiseDescription":[{"locale":"en-US","title":"Profile"},{"locale":"de-DE","title":"Profil","description":"Das Profil"}]}]}`,
+	"/scopes-srv/group/list":       `{"success":true,"data":[{"group_name":"developer","description":"developer scopes"}]}`,
+	"/groups-srv/graph/grouptypes": `{"success":true,"data":[{"groupType":"company","roleMode":"any_roles","allowedRoles":["member","admin"]}]}`,
+	"/apps-srv/clients/list":       `{"success":true,"data":[{"client_id":"app-client-id","client_name":"Sample App","client_type":"SINGLE_PAGE","allowed_scopes":["openid"]}]}`,
+	"/webhook-srv/webhook/list":    `{"success":true,"data":[{"_id":"hook-1","auth_type":"APIKEY","url":"https://example.com/hook","events":["ACCOUNT_MODIFIED"],"apikeyDetails":{"apikey_placeholder":"key","apikey_placement":"header","apikey":"secret"}}]}`,
+	"/templates-srv/template/custom/list": `{"success":true,"data":[{"templateKey":"WELCOME","templateType":"EMAIL","locale":"en-us","content":"Hi {{name}}","subject":"Welcome"},` +
+		`{"templateKey":"WELCOME","templateType":"EMAIL","locale":"de-de","content":"Hallo","subject":"Willkommen"}]}`,
+	"/registration-setup-srv/fields/list": `{"success":true,"data":[{"fieldKey":"company","dataType":"TEXT","fieldType":"CUSTOM","parent_group_id":"DEFAULT","enabled":true,"order":3,` +
+		`"fieldDefinition":{"maxLength":100},"localeTexts":[{"locale":"en-US","name":"Company","required":"Company is required"}]}]}`,
+	"/consent-management-srv/v2/consent/instance/all/list": `{"success":true,"data":[{"_id":"consent-id","consent_group_id":"group-id","consent_name":"newsletter","enabled":true}]}`,
+	"/providers-srv/providers/enabled/list":                `{"success":true,"data":[{"id":"provider-id","name":"Google","provider_name":"google","client_id":"google-client","client_secret":"google-secret","enabled":true}]}`,
+}
+
+func newTestTenant(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/groups-srv/graph/usergroups" {
+			var body map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			switch body["parentId"] {
+			case cidaas.RootUserGroupID:
+				_, _ = w.Write([]byte(`{"success":true,"data":{"groups":[{"groupId":"sales","groupName":"Sales","groupType":"company","parentId":"root"}]}}`))
+			case "sales":
+				_, _ = w.Write([]byte(`{"success":true,"data":{"groups":[{"groupId":"sales-eu","groupName":"Sales EU","groupType":"company","parentId":"sales"}]}}`))
+			default:
+				_, _ = w.Write([]byte(`{"success":true,"data":{"groups":[]}}`))
+			}
+			return
+		}
+		response, ok := tenantResponses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"error":"not available"}`))
+			return
+		}
+		_, _ = w.Write([]byte(response))
+	}))
+}
+
+func newTestClient(baseURL string) *cidaas.Client {
+	config := cidaas.ClientConfig{BaseURL: baseURL, AccessToken: "test-token"}
+	return &cidaas.Client{
+		Roles:          cidaas.NewRole(config),
+		Scopes:         cidaas.NewScope(config),
+		ScopeGroup:     cidaas.NewScopeGroup(config),
+		GroupType:      cidaas.NewGroupType(config),
+		UserGroup:      cidaas.NewUserGroup(config),
+		Apps:           cidaas.NewApp(config),
+		Webhook:        cidaas.NewWebhook(config),
+		Templates:      cidaas.NewTemplate(config),
+		RegFields:      cidaas.NewRegField(config),
+		Consent:        cidaas.NewConsent(config),
+		SocialProvider: cidaas.NewSocialProvider(config),
+		CustomProvider: cidaas.NewCustomProvider(config),
+	}
+}
+
+func TestRun(t *testing.T) {
+	server := newTestTenant(t)
+	defer server.Close()
+
+	outDir := filepath.Join(t.TempDir(), "generated")
+	result, err := Run(context.Background(), newTestClient(server.URL), Config{BaseURL: server.URL, OutDir: outDir})
+
+	// the custom providers are not available in the mock tenant, all other types are exported
+	if err == nil || !strings.Contains(err.Error(), "failed to export cidaas_custom_provider") {
+		t.Errorf("Expected the custom provider export to fail, got %v", err)
+	}
+	if result == nil {
+		t.Fatal("Expected a result, got nil")
+	}
+	if len(result.Resources) != 11 {
+		t.Errorf("Expected 11 exported resource types, got %d: %v", len(result.Resources), result.Resources)
+	}
+	if result.Resources["cidaas_user_group"] != 2 {
+		t.Errorf("Expected 2 user groups, got %d", result.Resources["cidaas_user_group"])
+	}
+
+	parser := hclparse.NewParser()
+	contents := map[string]string{}
+	for _, file := range result.Files {
+		if _, diags := parser.ParseHCLFile(file); diags.HasErrors() {
+			t.Errorf("Generated file %s is not valid HCL: %s", file, diags.Error())
+		}
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", file, err)
+		}
+		contents[filepath.Base(file)] = string(content)
+	}
+
+	expected := map[string][]string{
+		"cidaas_role.tf": {
+			`resource "cidaas_role" "admin" {`,
+			// the names of the resources are unique in a file
+			`resource "cidaas_role" "admin_2" {`,
+			"import {\n  to = cidaas_role.admin_2\n  id = \"ADMIN\"\n}",
+		},
+		"cidaas_scope.tf": {
+			`de-DE = {`,
+			`description = "Das Profil"`,
+		},
+		"cidaas_user_group.tf": {
+			`parent_id = "sales"`,
+			"to = cidaas_user_group.sales-eu",
+		},
+		"cidaas_app.tf":          {`id = "app-client-id"`, `allowed_scopes = ["openid"]`},
+		"cidaas_webhook.tf":      {`key = var.webhook_hook-1_apikey`},
+		"cidaas_template_set.tf": {`id = "WELCOME:EMAIL"`, `de-de = {`, `subject = "Willkommen"`},
+		"cidaas_registration_field.tf": {
+			`max_length = 100`,
+			`required_msg = "Company is required"`,
+		},
+		"cidaas_consent.tf":         {`id = "group-id:newsletter"`},
+		"cidaas_social_provider.tf": {`client_secret = var.google_client_secret`, `id = "google:provider-id"`},
+		"provider.tf":               {`source = "Cidaas/cidaas"`, `base_url = "` + server.URL + `"`},
+		"variables.tf":              {`variable "google_client_secret" {`, `variable "webhook_hook-1_apikey" {`, `sensitive = true`},
+	}
+	for file, snippets := range expected {
+		content, ok := contents[file]
+		if !ok {
+			t.Errorf("Expected file %s to be written", file)
+			continue
+		}
+		// the alignment of the attributes is not relevant
+		content = strings.Join(strings.Fields(content), " ")
+		for _, snippet := range snippets {
+			if !strings.Contains(content, strings.Join(strings.Fields(snippet), " ")) {
+				t.Errorf("Expected %s to contain %q, got:\n%s", file, snippet, content)
+			}
+		}
+	}
+	if strings.Contains(contents["cidaas_social_provider.tf"], "google-secret") {
+		t.Error("Expected the client secret not to be exported")
+	}
+	if _, ok := contents["cidaas_custom_provider.tf"]; ok {
+		t.Error("Expected no file for the failed custom provider export")
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	testCases := map[string]string{
+		"Sample App":       "sample_app",
+		"cidaas:read":      "cidaas_read",
+		"WELCOME_EMAIL":    "welcome_email",
+		"1st-field":        "_1st-field",
+		"  ":               "resource",
+		"app.example.com/": "app_example_com",
+	}
+	for key, expected := range testCases {
+		if name := resourceName(key); name != expected {
+			t.Errorf("Expected resource name %s for %q, got %s", expected, key, name)
+		}
+	}
+}
+
+func TestUniqueName(t *testing.T) {
+	used := map[string]int{}
+	names := []string{
+		uniqueName("admin", used),
+		uniqueName("admin", used),
+		uniqueName("admin_2", used),
+		uniqueName("admin", used),
+	}
+	expected := []string{"admin", "admin_2", "admin_2_2", "admin_3"}
+	for i := range names {
+		if names[i] != expected[i] {
+			t.Errorf("Expected names %v, got %v", expected, names)
+			break
+		}
+	}
+}
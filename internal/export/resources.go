@@ -0,0 +1,500 @@
+package export
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func roles(ctx context.Context, client *cidaas.Client) ([]resourceBlock, error) {
+	all, err := client.Roles.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]resourceBlock, 0, len(all))
+	for _, role := range all {
+		blocks = append(blocks, resourceBlock{
+			Type:     "cidaas_role",
+			Name:     role.Role,
+			ImportID: role.Role,
+			Attributes: func(body *hclwrite.Body, _ func(string) hclwrite.Tokens) {
+				setString(body, "role", role.Role)
+				setString(body, "name", role.Name)
+				setString(body, "description", role.Description)
+			},
+		})
+	}
+	return blocks, nil
+}
+
+func scopes(ctx context.Context, client *cidaas.Client) ([]resourceBlock, error) {
+	all, err := client.Scopes.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]resourceBlock, 0, len(all))
+	for _, scope := range all {
+		blocks = append(blocks, resourceBlock{
+			Type:     "cidaas_scope",
+			Name:     scope.ScopeKey,
+			ImportID: scope.ScopeKey,
+			Attributes: func(body *hclwrite.Body, _ func(string) hclwrite.Tokens) {
+				setString(body, "scope_key", scope.ScopeKey)
+				setString(body, "security_level", scope.SecurityLevel)
+				body.SetAttributeValue("required_user_consent", cty.BoolVal(scope.RequiredUserConsent))
+				setStrings(body, "group_name", scope.GroupName)
+				if len(scope.LocaleWiseDescription) > 0 {
+					descriptions := map[string]cty.Value{}
+					for _, ld := range scope.LocaleWiseDescription {
+						descriptions[ld.Locale] = objectValue(map[string]cty.Value{
+							"title":       stringValue(ld.Title),
+							"description": stringValue(ld.Description),
+						})
+					}
+					body.SetAttributeValue("localized_descriptions", cty.ObjectVal(descriptions))
+				}
+			},
+		})
+	}
+	return blocks, nil
+}
+
+func scopeGroups(ctx context.Context, client *cidaas.Client) ([]resourceBlock, error) {
+	all, err := client.ScopeGroup.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]resourceBlock, 0, len(all))
+	for _, group := range all {
+		blocks = append(blocks, resourceBlock{
+			Type:     "cidaas_scope_group",
+			Name:     group.GroupName,
+			ImportID: group.GroupName,
+			Attributes: func(body *hclwrite.Body, _ func(string) hclwrite.Tokens) {
+				setString(body, "group_name", group.GroupName)
+				setString(body, "description", group.Description)
+			},
+		})
+	}
+	return blocks, nil
+}
+
+func groupTypes(ctx context.Context, client *cidaas.Client) ([]resourceBlock, error) {
+	all, err := client.GroupType.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]resourceBlock, 0, len(all))
+	for _, groupType := range all {
+		blocks = append(blocks, resourceBlock{
+			Type:     "cidaas_group_type",
+			Name:     groupType.GroupType,
+			ImportID: groupType.GroupType,
+			Attributes: func(body *hclwrite.Body, _ func(string) hclwrite.Tokens) {
+				setString(body, "group_type", groupType.GroupType)
+				setString(body, "role_mode", groupType.RoleMode)
+				setString(body, "description", groupType.Description)
+				setStrings(body, "allowed_roles", groupType.AllowedRoles)
+			},
+		})
+	}
+	return blocks, nil
+}
+
+// userGroups walks the group tree from the root group, parents are exported before their sub groups.
+func userGroups(ctx context.Context, client *cidaas.Client) ([]resourceBlock, error) {
+	groups, err := client.UserGroup.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]resourceBlock, 0, len(groups))
+	for _, group := range groups {
+		blocks = append(blocks, resourceBlock{
+			Type:     "cidaas_user_group",
+			Name:     group.GroupID,
+			ImportID: group.GroupID,
+			Attributes: func(body *hclwrite.Body, _ func(string) hclwrite.Tokens) {
+				setString(body, "group_type", group.GroupType)
+				setString(body, "group_id", group.GroupID)
+				setString(body, "group_name", group.GroupName)
+				setString(body, "parent_id", group.ParentID)
+				setString(body, "logo_url", group.LogoURL)
+				setString(body, "description", group.Description)
+				body.SetAttributeValue("make_first_user_admin", cty.BoolVal(group.MakeFirstUserAdmin))
+				setString(body, "member_profile_visibility", group.MemberProfileVisibility)
+				setString(body, "none_member_profile_visibility", group.NoneMemberProfileVisibility)
+				if len(group.CustomFields) > 0 {
+					fields := map[string]cty.Value{}
+					for key, value := range group.CustomFields {
+						fields[key] = cty.StringVal(value)
+					}
+					body.SetAttributeValue("custom_fields", cty.MapVal(fields))
+				}
+			},
+		})
+	}
+	return blocks, nil
+}
+
+func apps(ctx context.Context, client *cidaas.Client) ([]resourceBlock, error) {
+	all, err := client.Apps.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]resourceBlock, 0, len(all))
+	for _, app := range all {
+		blocks = append(blocks, resourceBlock{
+			Type:     "cidaas_app",
+			Name:     app.ClientName,
+			ImportID: app.ClientID,
+			Attributes: func(body *hclwrite.Body, _ func(string) hclwrite.Tokens) {
+				setString(body, "client_type", app.ClientType)
+				setString(body, "client_name", app.ClientName)
+				setString(body, "client_display_name", app.ClientDisplayName)
+				setString(body, "company_name", app.CompanyName)
+				setString(body, "company_address", app.CompanyAddress)
+				setString(body, "company_website", app.CompanyWebsite)
+				setStrings(body, "allowed_scopes", app.AllowedScopes)
+				setStrings(body, "allow_login_with", app.AllowLoginWith)
+				setStrings(body, "redirect_uris", app.RedirectURIS)
+				setStrings(body, "allowed_logout_urls", app.AllowedLogoutUrls)
+				setStrings(body, "response_types", app.ResponseTypes)
+				setStrings(body, "grant_types", app.GrantTypes)
+				setStrings(body, "allowed_web_origins", app.AllowedWebOrigins)
+				setStrings(body, "allowed_origins", app.AllowedOrigins)
+				setString(body, "hosted_page_group", app.HostedPageGroup)
+				setString(body, "template_group_id", app.TemplateGroupID)
+				if app.TokenLifetimeInSeconds != nil {
+					body.SetAttributeValue("token_lifetime_in_seconds", cty.NumberIntVal(*app.TokenLifetimeInSeconds))
+				}
+			},
+		})
+	}
+	return blocks, nil
+}
+
+func webhooks(ctx context.Context, client *cidaas.Client) ([]resourceBlock, error) {
+	all, err := client.Webhook.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]resourceBlock, 0, len(all))
+	for _, webhook := range all {
+		blocks = append(blocks, resourceBlock{
+			Type:     "cidaas_webhook",
+			Name:     "webhook_" + webhook.ID,
+			ImportID: webhook.ID,
+			Attributes: func(body *hclwrite.Body, secret func(string) hclwrite.Tokens) {
+				setString(body, "auth_type", webhook.AuthType)
+				setString(body, "url", webhook.URL)
+				setStrings(body, "events", webhook.Events)
+				body.SetAttributeValue("disable", cty.BoolVal(webhook.Disable))
+				switch webhook.AuthType {
+				case "APIKEY":
+					body.SetAttributeRaw("apikey_config", hclwrite.TokensForObject([]hclwrite.ObjectAttrTokens{
+						objectAttr("placeholder", hclwrite.TokensForValue(cty.StringVal(webhook.APIKeyDetails.ApikeyPlaceholder))),
+						objectAttr("placement", hclwrite.TokensForValue(cty.StringVal(webhook.APIKeyDetails.ApikeyPlacement))),
+						objectAttr("key", secret("apikey")),
+					}))
+				case "TOTP":
+					body.SetAttributeRaw("totp_config", hclwrite.TokensForObject([]hclwrite.ObjectAttrTokens{
+						objectAttr("placeholder", hclwrite.TokensForValue(cty.StringVal(webhook.TotpDetails.TotpPlaceholder))),
+						objectAttr("placement", hclwrite.TokensForValue(cty.StringVal(webhook.TotpDetails.TotpPlacement))),
+						objectAttr("key", secret("totp_key")),
+					}))
+				case "CIDAAS_OAUTH2":
+					body.SetAttributeValue("cidaas_auth_config", cty.ObjectVal(map[string]cty.Value{
+						"client_id": cty.StringVal(webhook.CidaasAuthDetails.ClientID),
+					}))
+				}
+			},
+		})
+	}
+	return blocks, nil
+}
+
+// templateSets groups the custom templates by template_key and template_type.
+func templateSets(ctx context.Context, client *cidaas.Client) ([]resourceBlock, error) {
+	all, err := client.Templates.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sets := map[string][]cidaas.TemplateModel{}
+	var keys []string
+	for _, template := range all {
+		key := strings.ToUpper(template.TemplateKey) + ":" + strings.ToUpper(template.TemplateType)
+		if _, ok := sets[key]; !ok {
+			keys = append(keys, key)
+		}
+		sets[key] = append(sets[key], template)
+	}
+
+	blocks := make([]resourceBlock, 0, len(keys))
+	for _, key := range keys {
+		templates := sets[key]
+		blocks = append(blocks, resourceBlock{
+			Type:     "cidaas_template_set",
+			Name:     strings.ReplaceAll(key, ":", "_"),
+			ImportID: key,
+			Attributes: func(body *hclwrite.Body, _ func(string) hclwrite.Tokens) {
+				setString(body, "template_key", strings.ToUpper(templates[0].TemplateKey))
+				setString(body, "template_type", strings.ToUpper(templates[0].TemplateType))
+				locales := map[string]cty.Value{}
+				for _, template := range templates {
+					locales[strings.ToLower(template.Locale)] = objectValue(map[string]cty.Value{
+						"subject": stringValue(template.Subject),
+						"content": cty.StringVal(template.Content),
+					})
+				}
+				body.SetAttributeValue("locales", cty.ObjectVal(locales))
+			},
+		})
+	}
+	return blocks, nil
+}
+
+func registrationFields(ctx context.Context, client *cidaas.Client) ([]resourceBlock, error) {
+	all, err := client.RegFields.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]resourceBlock, 0, len(all))
+	for _, field := range all {
+		blocks = append(blocks, resourceBlock{
+			Type:     "cidaas_registration_field",
+			Name:     field.FieldKey,
+			ImportID: field.FieldKey,
+			Attributes: func(body *hclwrite.Body, _ func(string) hclwrite.Tokens) {
+				setString(body, "field_key", field.FieldKey)
+				setString(body, "data_type", field.DataType)
+				setString(body, "field_type", field.FieldType)
+				setString(body, "parent_group_id", field.ParentGroupID)
+				body.SetAttributeValue("required", cty.BoolVal(field.Required))
+				body.SetAttributeValue("enabled", cty.BoolVal(field.Enabled))
+				body.SetAttributeValue("unique", cty.BoolVal(field.Unique))
+				body.SetAttributeValue("read_only", cty.BoolVal(field.ReadOnly))
+				body.SetAttributeValue("claimable", cty.BoolVal(field.Claimable))
+				body.SetAttributeValue("is_searchable", cty.BoolVal(field.IsSearchable))
+				body.SetAttributeValue("is_group", cty.BoolVal(field.IsGroup))
+				body.SetAttributeValue("is_list", cty.BoolVal(field.IsList))
+				body.SetAttributeValue("order", cty.NumberIntVal(field.Order))
+				setStrings(body, "scopes", field.Scopes)
+				setStrings(body, "consent_refs", field.ConsentRefs)
+				if len(field.LocaleTexts) > 0 {
+					body.SetAttributeValue("local_texts", localTexts(field.LocaleTexts))
+				}
+				if fd := field.FieldDefinition; fd != nil {
+					definition := objectValue(map[string]cty.Value{
+						"min_length":        int64Value(fd.MinLength),
+						"max_length":        int64Value(fd.MaxLength),
+						"min_date":          timeValue(fd.MinDate),
+						"max_date":          timeValue(fd.MaxDate),
+						"initial_date":      timeValue(fd.InitialDate),
+						"initial_date_view": stringValue(fd.InitialDateView),
+						"regex":             stringValue(fd.Regex),
+					})
+					if definition.LengthInt() > 0 {
+						body.SetAttributeValue("field_definition", definition)
+					}
+				}
+			},
+		})
+	}
+	return blocks, nil
+}
+
+func localTexts(texts []*cidaas.LocaleText) cty.Value {
+	values := make([]cty.Value, 0, len(texts))
+	for _, text := range texts {
+		attributes := cty.NullVal(cty.DynamicPseudoType)
+		if len(text.Attributes) > 0 {
+			items := make([]cty.Value, 0, len(text.Attributes))
+			for _, attribute := range text.Attributes {
+				items = append(items, cty.ObjectVal(map[string]cty.Value{
+					"key":   cty.StringVal(attribute.Key),
+					"value": cty.StringVal(attribute.Value),
+				}))
+			}
+			attributes = cty.TupleVal(items)
+		}
+		consentLabel := cty.NullVal(cty.DynamicPseudoType)
+		if text.ConsentLabel != nil {
+			consentLabel = objectValue(map[string]cty.Value{
+				"label":      stringValue(text.ConsentLabel.Label),
+				"label_text": stringValue(text.ConsentLabel.LabelText),
+			})
+		}
+		values = append(values, objectValue(map[string]cty.Value{
+			"locale":         stringValue(text.Locale),
+			"name":           stringValue(text.Name),
+			"required_msg":   stringValue(text.RequiredMsg),
+			"min_length_msg": stringValue(text.MinLengthErrorMsg),
+			"max_length_msg": stringValue(text.MaxLengthErrorMsg),
+			"attributes":     attributes,
+			"consent_label":  consentLabel,
+		}))
+	}
+	return cty.TupleVal(values)
+}
+
+func consents(ctx context.Context, client *cidaas.Client) ([]resourceBlock, error) {
+	all, err := client.Consent.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]resourceBlock, 0, len(all))
+	for _, consent := range all {
+		blocks = append(blocks, resourceBlock{
+			Type:     "cidaas_consent",
+			Name:     consent.ConsentName,
+			ImportID: consent.ConsentGroupID + ":" + consent.ConsentName,
+			Attributes: func(body *hclwrite.Body, _ func(string) hclwrite.Tokens) {
+				setString(body, "consent_group_id", consent.ConsentGroupID)
+				setString(body, "name", consent.ConsentName)
+				body.SetAttributeValue("enabled", cty.BoolVal(consent.Enabled))
+			},
+		})
+	}
+	return blocks, nil
+}
+
+func socialProviders(ctx context.Context, client *cidaas.Client) ([]resourceBlock, error) {
+	all, err := client.SocialProvider.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]resourceBlock, 0, len(all))
+	for _, provider := range all {
+		blocks = append(blocks, resourceBlock{
+			Type:     "cidaas_social_provider",
+			Name:     provider.Name,
+			ImportID: provider.ProviderName + ":" + provider.ID,
+			Attributes: func(body *hclwrite.Body, secret func(string) hclwrite.Tokens) {
+				setString(body, "name", provider.Name)
+				setString(body, "provider_name", provider.ProviderName)
+				body.SetAttributeValue("enabled", cty.BoolVal(provider.Enabled))
+				body.SetAttributeValue("enabled_for_admin_portal", cty.BoolVal(provider.EnabledForAdminPortal))
+				setString(body, "client_id", provider.ClientID)
+				body.SetAttributeRaw("client_secret", secret("client_secret"))
+				setStrings(body, "scopes", provider.Scopes)
+				if len(provider.UserInfoFields) > 0 {
+					fields := make([]cty.Value, 0, len(provider.UserInfoFields))
+					for _, field := range provider.UserInfoFields {
+						fields = append(fields, cty.ObjectVal(map[string]cty.Value{
+							"inner_key":       cty.StringVal(field.InnerKey),
+							"external_key":    cty.StringVal(field.ExternalKey),
+							"is_custom_field": cty.BoolVal(field.IsCustomField),
+							"is_system_field": cty.BoolVal(field.IsSystemField),
+						}))
+					}
+					body.SetAttributeValue("userinfo_fields", cty.TupleVal(fields))
+				}
+			},
+		})
+	}
+	return blocks, nil
+}
+
+func customProviders(ctx context.Context, client *cidaas.Client) ([]resourceBlock, error) {
+	all, err := client.CustomProvider.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]resourceBlock, 0, len(all))
+	for _, provider := range all {
+		blocks = append(blocks, resourceBlock{
+			Type:     "cidaas_custom_provider",
+			Name:     provider.ProviderName,
+			ImportID: provider.ProviderName,
+			Attributes: func(body *hclwrite.Body, secret func(string) hclwrite.Tokens) {
+				setString(body, "provider_name", provider.ProviderName)
+				setString(body, "display_name", provider.DisplayName)
+				setString(body, "standard_type", provider.StandardType)
+				setString(body, "logo_url", provider.LogoURL)
+				setString(body, "client_id", provider.ClientID)
+				body.SetAttributeRaw("client_secret", secret("client_secret"))
+				setString(body, "authorization_endpoint", provider.AuthorizationEndpoint)
+				setString(body, "token_endpoint", provider.TokenEndpoint)
+				setString(body, "userinfo_endpoint", provider.UserinfoEndpoint)
+				setString(body, "scope_display_label", provider.Scopes.DisplayLabel)
+				if len(provider.Scopes.Scopes) > 0 {
+					items := make([]cty.Value, 0, len(provider.Scopes.Scopes))
+					for _, scope := range provider.Scopes.Scopes {
+						items = append(items, cty.ObjectVal(map[string]cty.Value{
+							"scope_name":  cty.StringVal(scope.ScopeName),
+							"required":    cty.BoolVal(scope.Required),
+							"recommended": cty.BoolVal(scope.Recommended),
+						}))
+					}
+					body.SetAttributeValue("scopes", cty.TupleVal(items))
+				}
+				setStrings(body, "domains", provider.Domains)
+				body.SetAttributeValue("pkce", cty.BoolVal(provider.Pkce))
+			},
+		})
+	}
+	return blocks, nil
+}
+
+func setString(body *hclwrite.Body, name, value string) {
+	if value != "" {
+		body.SetAttributeValue(name, cty.StringVal(value))
+	}
+}
+
+func setStrings(body *hclwrite.Body, name string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+	items := make([]cty.Value, 0, len(sorted))
+	for _, value := range sorted {
+		items = append(items, cty.StringVal(value))
+	}
+	body.SetAttributeValue(name, cty.ListVal(items))
+}
+
+func stringValue(value string) cty.Value {
+	if value == "" {
+		return cty.NullVal(cty.String)
+	}
+	return cty.StringVal(value)
+}
+
+func int64Value(value *int64) cty.Value {
+	if value == nil {
+		return cty.NullVal(cty.Number)
+	}
+	return cty.NumberIntVal(*value)
+}
+
+func timeValue(value *time.Time) cty.Value {
+	if value == nil || value.IsZero() {
+		return cty.NullVal(cty.String)
+	}
+	return cty.StringVal(value.UTC().Format(time.RFC3339))
+}
+
+// objectValue drops the null attributes so that the optional attributes are not written.
+func objectValue(attributes map[string]cty.Value) cty.Value {
+	values := map[string]cty.Value{}
+	for name, value := range attributes {
+		if !value.IsNull() {
+			values[name] = value
+		}
+	}
+	return cty.ObjectVal(values)
+}
+
+func objectAttr(name string, value hclwrite.Tokens) hclwrite.ObjectAttrTokens {
+	return hclwrite.ObjectAttrTokens{
+		Name:  hclwrite.TokensForIdentifier(name),
+		Value: value,
+	}
+}
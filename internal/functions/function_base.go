@@ -0,0 +1,10 @@
+package functions
+
+const (
+	FUNCTION_TEMPLATE_PREVIEW   = "template_preview"   // nolint:stylecheck
+	FUNCTION_TEMPLATE_IMPORT_ID = "template_import_id" // nolint:stylecheck
+	FUNCTION_CONSENT_IMPORT_ID  = "consent_import_id"  // nolint:stylecheck
+	FUNCTION_SOCIAL_PROVIDER_ID = "social_provider_id" // nolint:stylecheck
+	FUNCTION_IS_VALID_LOCALE    = "is_valid_locale"    // nolint:stylecheck
+	FUNCTION_SCOPE_STRING       = "scope_string"       // nolint:stylecheck
+)
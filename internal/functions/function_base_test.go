@@ -0,0 +1,32 @@
+package functions_test
+
+import (
+	"fmt"
+	"testing"
+
+	acctest "github.com/Cidaas/terraform-provider-cidaas/internal/test"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// testFunction runs the steps with the provider functions registered in the provider.
+// Provider functions are available from terraform 1.8, the test is skipped for older versions.
+func testFunction(t *testing.T, steps ...resource.TestStep) {
+	t.Helper()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: steps,
+	})
+}
+
+// outputConfig returns a configuration with the output test set to the call of the provider function with the arguments.
+func outputConfig(function, args string) string {
+	return fmt.Sprintf(`
+		output "test" {
+			value = provider::cidaas::%s(%s)
+		}
+	`, function, args)
+}
@@ -0,0 +1,53 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ConsentImportIDFunction{}
+
+type ConsentImportIDFunction struct{}
+
+func NewConsentImportIDFunction() function.Function {
+	return &ConsentImportIDFunction{}
+}
+
+func (f *ConsentImportIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = FUNCTION_CONSENT_IMPORT_ID
+}
+
+func (f *ConsentImportIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds the import identifier of a cidaas_consent",
+		MarkdownDescription: "Builds the import identifier of a `cidaas_consent` in the format `consent_group_id:name`. The parts must not be empty or contain `:`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "consent_group_id",
+				MarkdownDescription: "The ID of the consent group, e.g. the `id` of a `cidaas_consent_group` resource.",
+			},
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The name of the consent.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ConsentImportIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var consentGroupID, name string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &consentGroupID, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	id, err := resources.ConsentImportID(consentGroupID, name)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, id))
+}
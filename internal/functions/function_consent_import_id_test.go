@@ -0,0 +1,33 @@
+package functions_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/Cidaas/terraform-provider-cidaas/internal/functions"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+)
+
+func TestConsentImportIDFunction(t *testing.T) {
+	testFunction(t, resource.TestStep{
+		Config: outputConfig(functions.FUNCTION_CONSENT_IMPORT_ID, `"a0508317-cec9-4f3e-afa4", "sample_consent"`),
+		ConfigStateChecks: []statecheck.StateCheck{
+			statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("a0508317-cec9-4f3e-afa4:sample_consent")),
+		},
+	})
+}
+
+func TestConsentImportIDFunction_Invalid(t *testing.T) {
+	testFunction(t,
+		resource.TestStep{
+			Config:      outputConfig(functions.FUNCTION_CONSENT_IMPORT_ID, `"", "sample_consent"`),
+			ExpectError: regexp.MustCompile(`Invalid\s+consent_group_id\s+provided`),
+		},
+		resource.TestStep{
+			Config:      outputConfig(functions.FUNCTION_CONSENT_IMPORT_ID, `"a0508317", "sample:consent"`),
+			ExpectError: regexp.MustCompile(`Invalid\s+name\s+provided`),
+		},
+	)
+}
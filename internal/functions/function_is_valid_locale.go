@@ -0,0 +1,54 @@
+package functions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &IsValidLocaleFunction{}
+
+type IsValidLocaleFunction struct{}
+
+func NewIsValidLocaleFunction() function.Function {
+	return &IsValidLocaleFunction{}
+}
+
+func (f *IsValidLocaleFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = FUNCTION_IS_VALID_LOCALE
+}
+
+func (f *IsValidLocaleFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Checks whether a locale is supported by cidaas",
+		MarkdownDescription: "Returns `true` if the locale is one of the locales supported by cidaas, e.g. `en-US`." +
+			" The comparison ignores the case as templates expect the locale in lowercase while other resources use the original case." +
+			" The function can be used in the `validation` block of a variable.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "locale",
+				MarkdownDescription: "The locale to check.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *IsValidLocaleFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var locale string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &locale))
+	if resp.Error != nil {
+		return
+	}
+
+	valid := false
+	for _, l := range util.Locales {
+		if strings.EqualFold(l.LocaleString, locale) {
+			valid = true
+			break
+		}
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, valid))
+}
@@ -0,0 +1,32 @@
+package functions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Cidaas/terraform-provider-cidaas/internal/functions"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+)
+
+func TestIsValidLocaleFunction(t *testing.T) {
+	testCases := map[string]bool{
+		"en-US":   true,
+		"de-de":   true,
+		"zh-Hant": true,
+		"en_US":   false,
+		"xx":      false,
+		"":        false,
+	}
+	steps := make([]resource.TestStep, 0, len(testCases))
+	for locale, expected := range testCases {
+		steps = append(steps, resource.TestStep{
+			Config: outputConfig(functions.FUNCTION_IS_VALID_LOCALE, fmt.Sprintf("%q", locale)),
+			ConfigStateChecks: []statecheck.StateCheck{
+				statecheck.ExpectKnownOutputValue("test", knownvalue.Bool(expected)),
+			},
+		})
+	}
+	testFunction(t, steps...)
+}
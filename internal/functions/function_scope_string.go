@@ -0,0 +1,62 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ScopeStringFunction{}
+
+type ScopeStringFunction struct{}
+
+func NewScopeStringFunction() function.Function {
+	return &ScopeStringFunction{}
+}
+
+func (f *ScopeStringFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = FUNCTION_SCOPE_STRING
+}
+
+func (f *ScopeStringFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Joins scopes to a space delimited scope string",
+		MarkdownDescription: "Joins a list of scopes, e.g. the `allowed_scopes` of a `cidaas_app`, to the space delimited scope string of OAuth2 requests." +
+			" Surrounding whitespace and empty scopes are removed and duplicate scopes are kept only once, in the order of their first occurrence." +
+			" A scope containing whitespace fails the function as it can not be represented in the scope string.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "scopes",
+				ElementType:         types.StringType,
+				MarkdownDescription: "The scopes to join, e.g. `[\"openid\", \"profile\"]`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ScopeStringFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var scopes []string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &scopes))
+	if resp.Error != nil {
+		return
+	}
+
+	result := make([]string, 0, len(scopes))
+	for i, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		if scope == "" || util.Contains(result, scope) {
+			continue
+		}
+		if strings.ContainsAny(scope, " \t\r\n") {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("The scope at index %d must not contain whitespace, got: %q", i, scope))
+			return
+		}
+		result = append(result, scope)
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, strings.Join(result, " ")))
+}
@@ -0,0 +1,36 @@
+package functions_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/Cidaas/terraform-provider-cidaas/internal/functions"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+)
+
+func TestScopeStringFunction(t *testing.T) {
+	testFunction(t, resource.TestStep{
+		Config: outputConfig(functions.FUNCTION_SCOPE_STRING, `["openid", " profile ", "", "email", "openid"]`),
+		ConfigStateChecks: []statecheck.StateCheck{
+			statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("openid profile email")),
+		},
+	})
+}
+
+func TestScopeStringFunction_Empty(t *testing.T) {
+	testFunction(t, resource.TestStep{
+		Config: outputConfig(functions.FUNCTION_SCOPE_STRING, `[]`),
+		ConfigStateChecks: []statecheck.StateCheck{
+			statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("")),
+		},
+	})
+}
+
+func TestScopeStringFunction_Whitespace(t *testing.T) {
+	testFunction(t, resource.TestStep{
+		Config:      outputConfig(functions.FUNCTION_SCOPE_STRING, `["openid", "read write"]`),
+		ExpectError: regexp.MustCompile(`Invalid value for "scopes" parameter: The scope at index 1 must not\s+contain\s+whitespace`),
+	})
+}
@@ -0,0 +1,54 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &SocialProviderIDFunction{}
+
+type SocialProviderIDFunction struct{}
+
+func NewSocialProviderIDFunction() function.Function {
+	return &SocialProviderIDFunction{}
+}
+
+func (f *SocialProviderIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = FUNCTION_SOCIAL_PROVIDER_ID
+}
+
+func (f *SocialProviderIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds the import identifier of a cidaas_social_provider",
+		MarkdownDescription: "Builds the import identifier of a `cidaas_social_provider` in the format `provider_name:provider_id`." +
+			" The `provider_name` must be one of the providers supported by the resource.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "provider_name",
+				MarkdownDescription: "The name of the social provider, e.g. `google`.",
+			},
+			function.StringParameter{
+				Name:                "provider_id",
+				MarkdownDescription: "The ID of the social provider in cidaas, e.g. the `id` of a `cidaas_social_provider` resource.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SocialProviderIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var providerName, providerID string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &providerName, &providerID))
+	if resp.Error != nil {
+		return
+	}
+
+	id, err := resources.SocialProviderImportID(providerName, providerID)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, id))
+}
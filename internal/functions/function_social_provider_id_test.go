@@ -0,0 +1,27 @@
+package functions_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/Cidaas/terraform-provider-cidaas/internal/functions"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+)
+
+func TestSocialProviderIDFunction(t *testing.T) {
+	testFunction(t, resource.TestStep{
+		Config: outputConfig(functions.FUNCTION_SOCIAL_PROVIDER_ID, `"google", "8d789b3d-b312-4c44"`),
+		ConfigStateChecks: []statecheck.StateCheck{
+			statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("google:8d789b3d-b312-4c44")),
+		},
+	})
+}
+
+func TestSocialProviderIDFunction_InvalidProviderName(t *testing.T) {
+	testFunction(t, resource.TestStep{
+		Config:      outputConfig(functions.FUNCTION_SOCIAL_PROVIDER_ID, `"myspace", "8d789b3d-b312-4c44"`),
+		ExpectError: regexp.MustCompile(`Invalid\s+provider_name\s+provided`),
+	})
+}
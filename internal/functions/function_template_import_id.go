@@ -0,0 +1,70 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &TemplateImportIDFunction{}
+
+type TemplateImportIDFunction struct{}
+
+func NewTemplateImportIDFunction() function.Function {
+	return &TemplateImportIDFunction{}
+}
+
+func (f *TemplateImportIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = FUNCTION_TEMPLATE_IMPORT_ID
+}
+
+func (f *TemplateImportIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds the import identifier of a cidaas_template",
+		MarkdownDescription: "Builds the import identifier of a `cidaas_template` in the format `template_key:template_type:locale`." +
+			" The parts are validated the same way as the import of the resource, an invalid part fails the function." +
+			" The `usage_type` must be `null`, it is only set for system templates which can not be imported.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "template_key",
+				MarkdownDescription: "The key of the template in uppercase, e.g. `VERIFY_USER`.",
+			},
+			function.StringParameter{
+				Name:                "template_type",
+				MarkdownDescription: "The type of the template. Allowed values are `EMAIL`, `SMS`, `IVR` and `PUSH`.",
+			},
+			function.StringParameter{
+				Name:                "locale",
+				MarkdownDescription: "The locale of the template in lowercase, e.g. `de-de`.",
+			},
+			function.StringParameter{
+				Name:                "usage_type",
+				AllowNullValue:      true,
+				MarkdownDescription: "The usage type of the template. Pass `null`, a usage type fails the function as system templates can not be imported.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *TemplateImportIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var templateKey, templateType, locale string
+	var usageType *string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &templateKey, &templateType, &locale, &usageType))
+	if resp.Error != nil {
+		return
+	}
+	if usageType != nil {
+		resp.Error = function.NewArgumentFuncError(3, fmt.Sprintf("System templates can not be imported, expected usage_type to be null. Got: %s", *usageType))
+		return
+	}
+
+	id, err := resources.TemplateImportID(templateKey, templateType, locale)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, id))
+}
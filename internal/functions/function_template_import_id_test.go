@@ -0,0 +1,43 @@
+package functions_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/Cidaas/terraform-provider-cidaas/internal/functions"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+)
+
+// usage_type is null for the import id of a custom template
+func TestTemplateImportIDFunction(t *testing.T) {
+	testFunction(t, resource.TestStep{
+		Config: outputConfig(functions.FUNCTION_TEMPLATE_IMPORT_ID, `"VERIFY_USER", "EMAIL", "de-de", null`),
+		ConfigStateChecks: []statecheck.StateCheck{
+			statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("VERIFY_USER:EMAIL:de-de")),
+		},
+	})
+}
+
+func TestTemplateImportIDFunction_Invalid(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     string
+		expected string
+	}{
+		{"lowercase key", `"verify_user", "EMAIL", "de-de", null`, `template_key\s+to\s+be\s+in\s+uppercase`},
+		{"invalid type", `"VERIFY_USER", "FAX", "de-de", null`, `Invalid\s+template_type\s+provided`},
+		{"invalid locale", `"VERIFY_USER", "EMAIL", "xx-yy", null`, `Invalid\s+locale\s+provided`},
+		{"usage type", `"VERIFY_USER", "EMAIL", "de-de", "GENERAL"`, `Invalid value for "usage_type" parameter: System templates can not\s+be\s+imported`},
+		{"missing usage type", `"VERIFY_USER", "EMAIL", "de-de"`, `Not\s+enough\s+function\s+arguments`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testFunction(t, resource.TestStep{
+				Config:      outputConfig(functions.FUNCTION_TEMPLATE_IMPORT_ID, tc.args),
+				ExpectError: regexp.MustCompile(tc.expected),
+			})
+		})
+	}
+}
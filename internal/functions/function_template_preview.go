@@ -0,0 +1,98 @@
+package functions
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &TemplatePreviewFunction{}
+
+type TemplatePreviewFunction struct{}
+
+func NewTemplatePreviewFunction() function.Function {
+	return &TemplatePreviewFunction{}
+}
+
+var templatePreviewAttrTypes = map[string]attr.Type{
+	"content":                 types.StringType,
+	"subject":                 types.StringType,
+	"unresolved_placeholders": types.ListType{ElemType: types.StringType},
+}
+
+func (f *TemplatePreviewFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = FUNCTION_TEMPLATE_PREVIEW
+}
+
+func (f *TemplatePreviewFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Renders the content and subject of a template with sample values",
+		MarkdownDescription: "Renders the `content` and `subject` of a template with the provided sample `variables` using the placeholder syntax of cidaas templates." +
+			" The values of `{{placeholder}}` are HTML escaped, the values of `{{{placeholder}}}` are inserted as they are." +
+			" Placeholders without a value are kept in the output and listed in `unresolved_placeholders`." +
+			" Block helpers such as `{{#if name}}` are not evaluated." +
+			"\n\n The function runs offline and does not call the cidaas API, which allows asserting on rendered templates in CI.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "content",
+				MarkdownDescription: "The content of the template, e.g. the `content` of a `cidaas_template` resource.",
+			},
+			function.StringParameter{
+				Name:                "subject",
+				AllowNullValue:      true,
+				MarkdownDescription: "The subject of the template. Pass `null` for templates without a subject.",
+			},
+			function.MapParameter{
+				Name:                "variables",
+				ElementType:         types.StringType,
+				MarkdownDescription: "The sample values by placeholder name without curly braces, e.g. `{ name = \"John\" }`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: templatePreviewAttrTypes,
+		},
+	}
+}
+
+func (f *TemplatePreviewFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var content string
+	var subject *string
+	var variables map[string]string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &content, &subject, &variables))
+	if resp.Error != nil {
+		return
+	}
+
+	renderedContent, unresolved := cidaas.RenderTemplate(content, variables)
+	renderedSubject := types.StringNull()
+	if subject != nil {
+		rendered, unresolvedSubject := cidaas.RenderTemplate(*subject, variables)
+		renderedSubject = types.StringValue(rendered)
+		for _, name := range unresolvedSubject {
+			if !util.Contains(unresolved, name) {
+				unresolved = append(unresolved, name)
+			}
+		}
+		sort.Strings(unresolved)
+	}
+
+	unresolvedValues := make([]attr.Value, len(unresolved))
+	for i, name := range unresolved {
+		unresolvedValues[i] = types.StringValue(name)
+	}
+	result, diags := types.ObjectValue(templatePreviewAttrTypes, map[string]attr.Value{
+		"content":                 types.StringValue(renderedContent),
+		"subject":                 renderedSubject,
+		"unresolved_placeholders": types.ListValueMust(types.StringType, unresolvedValues),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,43 @@
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/Cidaas/terraform-provider-cidaas/internal/functions"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+)
+
+func TestTemplatePreviewFunction_Rendered(t *testing.T) {
+	testFunction(t, resource.TestStep{
+		Config: outputConfig(functions.FUNCTION_TEMPLATE_PREVIEW, `
+			"Hi {{name}}, click {{{verify_link}}} or use the code {{code}}",
+			"Welcome {{name}} to {{account_name}}",
+			{ name = "John", verify_link = "https://example.com/verify" }
+		`),
+		ConfigStateChecks: []statecheck.StateCheck{
+			statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+				"content": knownvalue.StringExact("Hi John, click https://example.com/verify or use the code {{code}}"),
+				"subject": knownvalue.StringExact("Welcome John to {{account_name}}"),
+				"unresolved_placeholders": knownvalue.ListExact([]knownvalue.Check{
+					knownvalue.StringExact("account_name"),
+					knownvalue.StringExact("code"),
+				}),
+			})),
+		},
+	})
+}
+
+func TestTemplatePreviewFunction_NullSubject(t *testing.T) {
+	testFunction(t, resource.TestStep{
+		Config: outputConfig(functions.FUNCTION_TEMPLATE_PREVIEW, `"Your code is {{code}}", null, { code = "123456" }`),
+		ConfigStateChecks: []statecheck.StateCheck{
+			statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+				"content":                 knownvalue.StringExact("Your code is 123456"),
+				"subject":                 knownvalue.Null(),
+				"unresolved_placeholders": knownvalue.ListExact([]knownvalue.Check{}),
+			})),
+		},
+	})
+}
@@ -0,0 +1,37 @@
+package listresources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/datasources"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var appListFilter = datasources.FilterConfig{
+	"client_id":   {TypeFunc: datasources.FilterTypeString},
+	"client_name": {TypeFunc: datasources.FilterTypeString},
+	"client_type": {TypeFunc: datasources.FilterTypeString},
+}
+
+func NewAppListResource() list.ListResource {
+	return NewBaseListResource(BaseListResourceConfig[cidaas.AppModel]{
+		Name:        resources.RESOURCE_APP,
+		Description: fmt.Sprintf("The list resource `%s` lists the apps of your Cidaas instance.", resources.RESOURCE_APP),
+		Filter:      appListFilter,
+		GetAll: func(ctx context.Context, client *cidaas.Client) ([]cidaas.AppModel, error) {
+			return client.Apps.GetAll(ctx)
+		},
+		Item: func(app cidaas.AppModel) ListItem {
+			return ListItem{
+				DisplayName: app.ClientName,
+				Identity:    resources.AppIdentity{ClientID: types.StringValue(app.ClientID)},
+				ImportID:    app.ClientID,
+			}
+		},
+		Resource: resources.NewAppResource,
+	})
+}
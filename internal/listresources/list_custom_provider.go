@@ -0,0 +1,31 @@
+package listresources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/datasources"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewCustomProviderListResource() list.ListResource {
+	return NewBaseListResource(BaseListResourceConfig[cidaas.CustomProviderModel]{
+		Name:        resources.RESOURCE_CUSTOM_PROVIDER,
+		Description: fmt.Sprintf("The list resource `%s` lists the custom providers of your Cidaas instance.", resources.RESOURCE_CUSTOM_PROVIDER),
+		Filter:      datasources.CustomProviderFilter,
+		GetAll: func(ctx context.Context, client *cidaas.Client) ([]cidaas.CustomProviderModel, error) {
+			return client.CustomProvider.GetAll(ctx)
+		},
+		Item: func(cp cidaas.CustomProviderModel) ListItem {
+			return ListItem{
+				DisplayName: cp.DisplayName,
+				Identity:    resources.CustomProviderIdentity{ProviderName: types.StringValue(cp.ProviderName)},
+				ImportID:    cp.ProviderName,
+			}
+		},
+		Resource: resources.NewCustomProvider,
+	})
+}
@@ -0,0 +1,31 @@
+package listresources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/datasources"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewRegistrationFieldListResource() list.ListResource {
+	return NewBaseListResource(BaseListResourceConfig[cidaas.RegistrationFieldConfig]{
+		Name:        resources.RESOURCE_REGISTRATION_FIELD,
+		Description: fmt.Sprintf("The list resource `%s` lists the registration fields of your Cidaas instance.", resources.RESOURCE_REGISTRATION_FIELD),
+		Filter:      datasources.RegistrationFieldFilter,
+		GetAll: func(ctx context.Context, client *cidaas.Client) ([]cidaas.RegistrationFieldConfig, error) {
+			return client.RegFields.GetAll(ctx)
+		},
+		Item: func(field cidaas.RegistrationFieldConfig) ListItem {
+			return ListItem{
+				DisplayName: field.FieldKey,
+				Identity:    resources.RegFieldIdentity{FieldKey: types.StringValue(field.FieldKey)},
+				ImportID:    field.FieldKey,
+			}
+		},
+		Resource: resources.NewRegFieldResource,
+	})
+}
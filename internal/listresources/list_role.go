@@ -0,0 +1,31 @@
+package listresources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/datasources"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewRoleListResource() list.ListResource {
+	return NewBaseListResource(BaseListResourceConfig[cidaas.RoleModel]{
+		Name:        resources.RESOURCE_ROLE,
+		Description: fmt.Sprintf("The list resource `%s` lists the roles of your Cidaas instance.", resources.RESOURCE_ROLE),
+		Filter:      datasources.RoleFilter,
+		GetAll: func(ctx context.Context, client *cidaas.Client) ([]cidaas.RoleModel, error) {
+			return client.Roles.GetAll(ctx)
+		},
+		Item: func(role cidaas.RoleModel) ListItem {
+			return ListItem{
+				DisplayName: role.Role,
+				Identity:    resources.RoleIdentity{Role: types.StringValue(role.Role)},
+				ImportID:    role.Role,
+			}
+		},
+		Resource: resources.NewRoleResource,
+	})
+}
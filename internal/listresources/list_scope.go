@@ -0,0 +1,31 @@
+package listresources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/datasources"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewScopeListResource() list.ListResource {
+	return NewBaseListResource(BaseListResourceConfig[cidaas.ScopeModel]{
+		Name:        resources.RESOURCE_SCOPE,
+		Description: fmt.Sprintf("The list resource `%s` lists the scopes of your Cidaas instance.", resources.RESOURCE_SCOPE),
+		Filter:      datasources.ScopeFilter,
+		GetAll: func(ctx context.Context, client *cidaas.Client) ([]cidaas.ScopeModel, error) {
+			return client.Scopes.GetAll(ctx)
+		},
+		Item: func(scope cidaas.ScopeModel) ListItem {
+			return ListItem{
+				DisplayName: scope.ScopeKey,
+				Identity:    resources.ScopeIdentity{ScopeKey: types.StringValue(scope.ScopeKey)},
+				ImportID:    scope.ScopeKey,
+			}
+		},
+		Resource: resources.NewScopeResource,
+	})
+}
@@ -0,0 +1,34 @@
+package listresources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/datasources"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewSocialProviderListResource() list.ListResource {
+	return NewBaseListResource(BaseListResourceConfig[cidaas.SocialProviderModel]{
+		Name:        resources.RESOURCE_SOCIAL_PROVIDER,
+		Description: fmt.Sprintf("The list resource `%s` lists the social providers of your Cidaas instance.", resources.RESOURCE_SOCIAL_PROVIDER),
+		Filter:      datasources.SocialProviderFilter,
+		GetAll: func(ctx context.Context, client *cidaas.Client) ([]cidaas.SocialProviderModel, error) {
+			return client.SocialProvider.GetAll(ctx)
+		},
+		Item: func(sp cidaas.SocialProviderModel) ListItem {
+			return ListItem{
+				DisplayName: sp.Name,
+				Identity: resources.SocialProviderIdentity{
+					ProviderName: types.StringValue(sp.ProviderName),
+					ID:           types.StringValue(sp.ID),
+				},
+				ImportID: fmt.Sprintf("%s:%s", sp.ProviderName, sp.ID),
+			}
+		},
+		Resource: resources.NewSocialProvider,
+	})
+}
@@ -0,0 +1,38 @@
+package listresources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/datasources"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var userGroupListFilter = datasources.FilterConfig{
+	"group_id":   {TypeFunc: datasources.FilterTypeString},
+	"group_name": {TypeFunc: datasources.FilterTypeString},
+	"group_type": {TypeFunc: datasources.FilterTypeString},
+	"parent_id":  {TypeFunc: datasources.FilterTypeString},
+}
+
+func NewUserGroupListResource() list.ListResource {
+	return NewBaseListResource(BaseListResourceConfig[cidaas.UserGroupData]{
+		Name:        resources.RESOURCE_USER_GROUP,
+		Description: fmt.Sprintf("The list resource `%s` lists the user groups of your Cidaas instance. The root group created by cidaas is not listed.", resources.RESOURCE_USER_GROUP),
+		Filter:      userGroupListFilter,
+		GetAll: func(ctx context.Context, client *cidaas.Client) ([]cidaas.UserGroupData, error) {
+			return client.UserGroup.GetAll(ctx)
+		},
+		Item: func(group cidaas.UserGroupData) ListItem {
+			return ListItem{
+				DisplayName: group.GroupName,
+				Identity:    resources.UserGroupIdentity{GroupID: types.StringValue(group.GroupID)},
+				ImportID:    group.GroupID,
+			}
+		},
+		Resource: resources.NewUserGroupResource,
+	})
+}
@@ -0,0 +1,37 @@
+package listresources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/datasources"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var webhookListFilter = datasources.FilterConfig{
+	"url":       {TypeFunc: datasources.FilterTypeString},
+	"auth_type": {TypeFunc: datasources.FilterTypeString},
+	"disable":   {TypeFunc: datasources.FilterTypeBool},
+}
+
+func NewWebhookListResource() list.ListResource {
+	return NewBaseListResource(BaseListResourceConfig[cidaas.WebhookModel]{
+		Name:        resources.RESOURCE_WEBHOOK,
+		Description: fmt.Sprintf("The list resource `%s` lists the webhooks of your Cidaas instance.", resources.RESOURCE_WEBHOOK),
+		Filter:      webhookListFilter,
+		GetAll: func(ctx context.Context, client *cidaas.Client) ([]cidaas.WebhookModel, error) {
+			return client.Webhook.GetAll(ctx)
+		},
+		Item: func(webhook cidaas.WebhookModel) ListItem {
+			return ListItem{
+				DisplayName: webhook.URL,
+				Identity:    resources.WebhookIdentity{ID: types.StringValue(webhook.ID)},
+				ImportID:    webhook.ID,
+			}
+		},
+		Resource: resources.NewWebhookResource,
+	})
+}
@@ -0,0 +1,206 @@
+package listresources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/datasources"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ListItem describes a listed object of cidaas as an instance of the managed resource.
+type ListItem struct {
+	DisplayName string
+	// Identity is the identity model of the managed resource, e.g. resources.RoleIdentity.
+	Identity any
+	// ImportID is the ID which `terraform import` accepts for the object.
+	ImportID string
+}
+
+type BaseListResourceConfig[T any] struct {
+	Name        string
+	Description string
+	Filter      datasources.FilterConfig
+	// GetAll returns all objects of the resource type from the API.
+	GetAll func(ctx context.Context, client *cidaas.Client) ([]T, error)
+	Item   func(T) ListItem
+	// Resource is the constructor of the managed resource. Its ImportState and Read are used
+	// to populate the resource data of the list results when requested by Terraform.
+	Resource func() resource.Resource
+}
+
+type BaseListResource[T any] struct {
+	Config BaseListResourceConfig[T]
+	client *cidaas.Client
+}
+
+type FilterModel struct {
+	Filters datasources.FiltersModelType `tfsdk:"filter"`
+}
+
+func NewBaseListResource[T any](cfg BaseListResourceConfig[T]) *BaseListResource[T] {
+	return &BaseListResource[T]{
+		Config: cfg,
+	}
+}
+
+func (r *BaseListResource[T]) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = r.Config.Name
+}
+
+func (r *BaseListResource[T]) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = resources.GetResourceMeta(req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *BaseListResource[T]) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: r.Config.Description +
+			"\nYou can apply filters using the `filter` block in the `config` of the list block.",
+		Blocks: map[string]schema.Block{
+			"filter": r.Config.Filter.ListSchema(),
+		},
+	}
+}
+
+func (r *BaseListResource[T]) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config FilterModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		tflog.Error(ctx, "failed to get config data", util.H{
+			"errors": diags.Errors(),
+		})
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	elems, d := r.Config.Filter.GetAndFilter(ctx, r.client, config.Filters, r.listAll)
+	if d != nil {
+		tflog.Error(ctx, "failed to filter list resource data", util.H{
+			"resource_type": r.Config.Name,
+			"error":         d.Summary(),
+			"detail":        d.Detail(),
+		})
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{d})
+		return
+	}
+	items := datasources.AnySliceToTyped[T](elems)
+	tflog.Debug(ctx, "successfully filtered list resource data", util.H{
+		"resource_type": r.Config.Name,
+		"count":         len(items),
+	})
+
+	var managed resource.Resource
+	if req.IncludeResource {
+		managed, diags = r.managedResource(ctx)
+		if diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i, elem := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+			item := r.Config.Item(elem)
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = item.DisplayName
+			result.Diagnostics.Append(result.Identity.Set(ctx, item.Identity)...)
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				result.Diagnostics.Append(readResource(ctx, managed, item.ImportID, &result)...)
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+func (r *BaseListResource[T]) listAll(ctx context.Context, client *cidaas.Client) ([]any, error) {
+	all, err := r.Config.GetAll(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return datasources.TypedSliceToAny(all), nil
+}
+
+// managedResource returns the configured managed resource of the list resource.
+func (r *BaseListResource[T]) managedResource(ctx context.Context) (resource.Resource, diag.Diagnostics) {
+	managed := r.Config.Resource()
+	if configurable, ok := managed.(resource.ResourceWithConfigure); ok {
+		resp := resource.ConfigureResponse{}
+		configurable.Configure(ctx, resource.ConfigureRequest{ProviderData: r.client}, &resp)
+		if resp.Diagnostics.HasError() {
+			return nil, resp.Diagnostics
+		}
+	}
+	return managed, nil
+}
+
+// readResource populates the resource data of a list result the same way `terraform import` does,
+// by calling ImportState with the import ID followed by Read of the managed resource.
+func readResource(ctx context.Context, managed resource.Resource, importID string, result *list.ListResult) diag.Diagnostics {
+	var diags diag.Diagnostics
+	importer, ok := managed.(resource.ResourceWithImportState)
+	if !ok {
+		diags.AddError(
+			"Resource Import Not Implemented",
+			fmt.Sprintf("The resource data of %T can not be read without ImportState. Please report this issue to the provider developers.", managed),
+		)
+		return diags
+	}
+
+	importResp := resource.ImportStateResponse{
+		State:    tfsdk.State{Schema: result.Resource.Schema, Raw: result.Resource.Raw.Copy()},
+		Identity: &tfsdk.ResourceIdentity{Schema: result.Identity.Schema, Raw: result.Identity.Raw.Copy()},
+	}
+	importer.ImportState(ctx, resource.ImportStateRequest{ID: importID}, &importResp)
+	diags.Append(importResp.Diagnostics...)
+	if diags.HasError() {
+		return diags
+	}
+
+	readResp := resource.ReadResponse{
+		State:    importResp.State,
+		Identity: importResp.Identity,
+	}
+	managed.Read(ctx, resource.ReadRequest{State: importResp.State, Identity: importResp.Identity}, &readResp)
+	diags.Append(readResp.Diagnostics...)
+	if diags.HasError() {
+		return diags
+	}
+
+	result.Resource.Raw = readResp.State.Raw
+	result.Identity.Raw = readResp.Identity.Raw
+	return diags
+}
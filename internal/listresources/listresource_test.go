@@ -0,0 +1,183 @@
+package listresources_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	provider "github.com/Cidaas/terraform-provider-cidaas/internal"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/datasources"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/listresources"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func newRoleServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/groups-srv/graph/roles":
+			_, _ = w.Write([]byte(`{"success":true,"data":[{"role":"admin","name":"Admin"},{"role":"developer","name":"Developer"},{"role":"tester"}]}`))
+		case "/roles-srv/role":
+			_, _ = w.Write([]byte(`{"success":true,"data":{"role":"` + r.URL.Query().Get("role") + `","name":"Admin","description":"administrators"}}`))
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+// listRoles runs the role list resource against the mock server and returns the pushed results.
+func listRoles(t *testing.T, baseURL string, filters datasources.FiltersModelType, includeResource bool, limit int64) []list.ListResult {
+	t.Helper()
+	ctx := context.Background()
+	listResource := listresources.NewRoleListResource()
+	managed := resources.NewRoleResource()
+
+	client := &cidaas.Client{Roles: cidaas.NewRole(cidaas.ClientConfig{BaseURL: baseURL, AccessToken: "test-token"})}
+	configureResp := fwresource.ConfigureResponse{}
+	listResource.(list.ListResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{ProviderData: client}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("Configure failed: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := list.ListResourceSchemaResponse{}
+	listResource.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResp)
+	// the config is set through a state as tfsdk.Config can not be set directly
+	config := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := config.Set(ctx, listresources.FilterModel{Filters: filters}); diags.HasError() {
+		t.Fatalf("Failed to set config: %v", diags)
+	}
+
+	resourceSchema := fwresource.SchemaResponse{}
+	managed.Schema(ctx, fwresource.SchemaRequest{}, &resourceSchema)
+	identitySchema := fwresource.IdentitySchemaResponse{}
+	managed.(fwresource.ResourceWithIdentity).IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, &identitySchema)
+
+	stream := list.ListResultsStream{}
+	listResource.List(ctx, list.ListRequest{
+		Config:                 tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+		IncludeResource:        includeResource,
+		Limit:                  limit,
+		ResourceSchema:         resourceSchema.Schema,
+		ResourceIdentitySchema: identitySchema.IdentitySchema,
+	}, &stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		if result.Diagnostics.HasError() {
+			t.Fatalf("Unexpected error in list result: %v", result.Diagnostics)
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func TestRoleListResource_List(t *testing.T) {
+	server := newRoleServer(t)
+	defer server.Close()
+
+	results := listRoles(t, server.URL, nil, false, 0)
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 results, got %d", len(results))
+	}
+
+	var identity resources.RoleIdentity
+	if diags := results[1].Identity.Get(context.Background(), &identity); diags.HasError() {
+		t.Fatalf("Failed to get identity: %v", diags)
+	}
+	if identity.Role.ValueString() != "developer" {
+		t.Errorf("Expected identity role developer, got %s", identity.Role.ValueString())
+	}
+	if results[1].DisplayName != "developer" {
+		t.Errorf("Expected display name developer, got %s", results[1].DisplayName)
+	}
+	if !results[1].Resource.Raw.IsNull() {
+		t.Error("Expected no resource data when it is not requested")
+	}
+}
+
+func TestRoleListResource_ListWithFilterAndLimit(t *testing.T) {
+	server := newRoleServer(t)
+	defer server.Close()
+
+	filters := datasources.FiltersModelType{
+		{
+			Name:    types.StringValue("name"),
+			Values:  []types.String{types.StringValue("^[AD]")},
+			MatchBy: types.StringValue("regex"),
+		},
+	}
+	results := listRoles(t, server.URL, filters, false, 0)
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 results matching the filter, got %d", len(results))
+	}
+
+	results = listRoles(t, server.URL, filters, false, 1)
+	if len(results) != 1 {
+		t.Fatalf("Expected the results to be limited to 1, got %d", len(results))
+	}
+}
+
+func TestRoleListResource_ListIncludeResource(t *testing.T) {
+	server := newRoleServer(t)
+	defer server.Close()
+
+	results := listRoles(t, server.URL, nil, true, 1)
+	if len(results) != 1 {
+		t.Fatalf("Expected 1 result, got %d", len(results))
+	}
+
+	var id, description types.String
+	results[0].Resource.GetAttribute(context.Background(), path.Root("id"), &id)
+	results[0].Resource.GetAttribute(context.Background(), path.Root("description"), &description)
+	if id.ValueString() != "admin" {
+		t.Errorf("Expected the resource id admin, got %s", id.ValueString())
+	}
+	if description.ValueString() != "administrators" {
+		t.Errorf("Expected the description of the role to be read, got %s", description.ValueString())
+	}
+}
+
+func TestProvider_ListResourceSchemas(t *testing.T) {
+	server, err := providerserver.NewProtocol6WithError(provider.Cidaas("test")())()
+	if err != nil {
+		t.Fatalf("Failed to create provider server: %v", err)
+	}
+
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema failed: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("Unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	for _, name := range []string{
+		resources.RESOURCE_ROLE,
+		resources.RESOURCE_SCOPE,
+		resources.RESOURCE_APP,
+		resources.RESOURCE_WEBHOOK,
+		resources.RESOURCE_USER_GROUP,
+		resources.RESOURCE_REGISTRATION_FIELD,
+		resources.RESOURCE_CUSTOM_PROVIDER,
+		resources.RESOURCE_SOCIAL_PROVIDER,
+	} {
+		if _, ok := resp.ListResourceSchemas[name]; !ok {
+			t.Errorf("Expected a list resource schema for %s", name)
+		}
+	}
+}
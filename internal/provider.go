@@ -8,8 +8,12 @@ import (
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
 	cidaasDataSources "github.com/Cidaas/terraform-provider-cidaas/internal/datasources"
+	cidaasFunctions "github.com/Cidaas/terraform-provider-cidaas/internal/functions"
+	cidaasListResources "github.com/Cidaas/terraform-provider-cidaas/internal/listresources"
 	cidaasResource "github.com/Cidaas/terraform-provider-cidaas/internal/resources"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,6 +21,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+var (
+	_ provider.ProviderWithFunctions     = &cidaasProvider{}
+	_ provider.ProviderWithListResources = &cidaasProvider{}
+)
+
 type cidaasProvider struct {
 	version string
 }
@@ -60,12 +69,15 @@ func (p *cidaasProvider) DataSources(_ context.Context) []func() datasource.Data
 		cidaasDataSources.NewSocialProvider,
 		cidaasDataSources.NewCustomProvider,
 		cidaasDataSources.NewRegistrationField,
+		cidaasDataSources.NewTenant,
 	}
 }
 
 func (p *cidaasProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		cidaasResource.NewRoleResource,
+		cidaasResource.NewUserRoleResource,
+		cidaasResource.NewRoleMembersResource,
 		cidaasResource.NewCustomProvider,
 		cidaasResource.NewSocialProvider,
 		cidaasResource.NewScopeResource,
@@ -73,15 +85,48 @@ func (p *cidaasProvider) Resources(_ context.Context) []func() resource.Resource
 		cidaasResource.NewConsentGroupResource,
 		cidaasResource.NewGroupTypeResource,
 		cidaasResource.NewUserGroupResource,
+		cidaasResource.NewUserGroupsResource,
+		cidaasResource.NewGroupCustomFieldResource,
+		cidaasResource.NewUserResource,
+		cidaasResource.NewUserGroupMembershipResource,
 		cidaasResource.NewHostedPageResource,
 		cidaasResource.NewWebhookResource,
 		cidaasResource.NewAppResource,
+		cidaasResource.NewAppGroupResource,
 		cidaasResource.NewRegFieldResource,
+		cidaasResource.NewRegFieldOrderResource,
 		cidaasResource.NewTemplateGroupResource,
 		cidaasResource.NewTemplateResource,
+		cidaasResource.NewTemplateSetResource,
 		cidaasResource.NewPasswordPolicy,
 		cidaasResource.NewConsentResource,
 		cidaasResource.NewConsentVersionResource,
+		cidaasResource.NewConsentPolicyResource,
+		cidaasResource.NewTenantSettingsResource,
+	}
+}
+
+func (p *cidaasProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		cidaasFunctions.NewTemplatePreviewFunction,
+		cidaasFunctions.NewTemplateImportIDFunction,
+		cidaasFunctions.NewConsentImportIDFunction,
+		cidaasFunctions.NewSocialProviderIDFunction,
+		cidaasFunctions.NewIsValidLocaleFunction,
+		cidaasFunctions.NewScopeStringFunction,
+	}
+}
+
+func (p *cidaasProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		cidaasListResources.NewRoleListResource,
+		cidaasListResources.NewScopeListResource,
+		cidaasListResources.NewAppListResource,
+		cidaasListResources.NewWebhookListResource,
+		cidaasListResources.NewUserGroupListResource,
+		cidaasListResources.NewRegistrationFieldListResource,
+		cidaasListResources.NewCustomProviderListResource,
+		cidaasListResources.NewSocialProviderListResource,
 	}
 }
 
@@ -141,6 +186,7 @@ func (p *cidaasProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 	resp.ResourceData = client
 	resp.DataSourceData = client
+	resp.ListResourceData = client
 	tflog.Info(ctx, "Provider configured successfully", util.H{
 		"base_url": data.BaseURL.ValueString(),
 	})
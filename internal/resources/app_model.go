@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -31,6 +32,7 @@ type AppConfig struct {
 	CompanyAddress                  types.String `tfsdk:"company_address"`
 	CompanyWebsite                  types.String `tfsdk:"company_website"`
 	TemplateGroupID                 types.String `tfsdk:"template_group_id"`
+	ClientGroupID                   types.String `tfsdk:"client_group_id"`
 	ClientID                        types.String `tfsdk:"client_id"`
 	ClientSecret                    types.String `tfsdk:"client_secret"`
 	PolicyURI                       types.String `tfsdk:"policy_uri"`
@@ -160,6 +162,8 @@ type AppConfig struct {
 	mobileSettings             *AppMobileSettings
 	suggestVerificationMethods *SuggestVerificationMethods
 	groupRoleRestriction       *GroupRoleRestriction
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type AllowedGroups struct {
@@ -328,6 +332,7 @@ func prepareAppModel(ctx context.Context, plan AppConfig) (*cidaas.AppModel, dia
 		IDTokenLifetimeInSeconds:      plan.IDTokenLifetimeInSeconds.ValueInt64Pointer(),
 		RefreshTokenLifetimeInSeconds: plan.RefreshTokenLifetimeInSeconds.ValueInt64Pointer(),
 		TemplateGroupID:               plan.TemplateGroupID.ValueString(),
+		ClientGroupID:                 plan.ClientGroupID.ValueString(),
 		ClientID:                      plan.ClientID.ValueString(),
 		ClientSecret:                  plan.ClientSecret.ValueString(),
 		PolicyURI:                     plan.PolicyURI.ValueString(),
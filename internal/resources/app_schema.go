@@ -234,6 +234,10 @@ var resourceAppSchema = schema.Schema{
 			Optional:            true,
 			MarkdownDescription: "The id of the template group to be configured for commenication. Default is set to the system default group.",
 		},
+		"client_group_id": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "The id of the app group the app belongs to, for example the `client_group_id` of a `cidaas_app_group` resource.",
+		},
 		"client_id": schema.StringAttribute{
 			Optional: true,
 			Computed: true,
@@ -0,0 +1,94 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	// consistencyTimeout is the maximum time an object is re-read after a write. The wait ends at the latest
+	// when consistencyShare of the time left until the deadline of the operation has passed.
+	consistencyTimeout = time.Minute
+	consistencyShare   = 0.9
+	// consistencyMinDelay and consistencyMaxDelay bound the backoff between the reads of an object.
+	consistencyMinDelay = 250 * time.Millisecond
+	consistencyMaxDelay = 5 * time.Second
+)
+
+// waitForConsistency re-reads an object after a create or update until consistent reports that the
+// relevant fields match the plan. cidaas replicates writes asynchronously, so a read right after a write
+// can return stale data or no object at all. The delay between the reads doubles with every attempt and
+// is jittered so that parallel applies do not poll in lockstep.
+//
+// A warning is added if the object is still not consistent when the wait ends. The write itself succeeded,
+// so the wait ends before the deadline of the operation to leave time to save the state, and the difference
+// shows up in the next plan.
+func waitForConsistency[T any](
+	ctx context.Context,
+	name string,
+	read func(ctx context.Context) (*T, error),
+	consistent func(*T) bool,
+	diags *diag.Diagnostics,
+) {
+	budget := consistencyTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		budget = min(budget, time.Duration(float64(time.Until(deadline))*consistencyShare))
+	}
+	ctx, cancel := context.WithTimeout(ctx, budget)
+	defer cancel()
+
+	delay := consistencyMinDelay
+	for attempt := 1; ; attempt++ {
+		res, err := read(ctx)
+		if err == nil && consistent(res) {
+			tflog.Debug(ctx, "object is consistent after write", util.H{
+				"object":   name,
+				"attempts": attempt,
+			})
+			return
+		}
+
+		reason := "the read data does not match the plan"
+		if err != nil {
+			reason = err.Error()
+		}
+		tflog.Debug(ctx, "object is not consistent after write, retrying", util.H{
+			"object":  name,
+			"attempt": attempt,
+			"reason":  reason,
+		})
+
+		// full jitter within the upper half of the delay
+		wait := delay/2 + rand.N(delay/2+1) //nolint:gosec // the jitter does not need a secure random source
+		select {
+		case <-ctx.Done():
+			diags.AddWarning(
+				fmt.Sprintf("%s is not consistent after apply", name),
+				fmt.Sprintf("cidaas did not return the applied %s after %d reads: %s."+
+					" The changes were applied, a difference in the next plan resolves once cidaas has replicated them.",
+					name, attempt, reason),
+			)
+			return
+		case <-time.After(wait):
+		}
+		delay = min(delay*2, consistencyMaxDelay)
+	}
+}
+
+// sameElements reports whether the read and the written values contain the same strings in any order.
+func sameElements(read, written []string) bool {
+	if len(read) != len(written) {
+		return false
+	}
+	read, written = slices.Clone(read), slices.Clone(written)
+	slices.Sort(read)
+	slices.Sort(written)
+	return slices.Equal(read, written)
+}
@@ -0,0 +1,213 @@
+package resources_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	testGroup      = `{"success":true,"data":{"_id":"id","groupId":"team","groupName":"Team","groupType":"department","parentId":"root"}}`
+	testStaleGroup = `{"success":true,"data":{"_id":"id","groupId":"team","groupName":"Old Team","groupType":"department","parentId":"root"}}`
+)
+
+// createUserGroup creates the user group team with the handler as cidaas API.
+func createUserGroup(t *testing.T, handler http.HandlerFunc, groupTimeouts timeouts.Value) fwresource.CreateResponse {
+	t.Helper()
+	ctx := context.Background()
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	r := resources.NewUserGroupResource()
+	client := &cidaas.Client{UserGroup: cidaas.NewUserGroup(cidaas.ClientConfig{BaseURL: server.URL, AccessToken: "test-token"})}
+	configureResp := fwresource.ConfigureResponse{}
+	r.(fwresource.ResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{ProviderData: client}, &configureResp)
+
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := plan.Set(ctx, resources.UserGroupConfig{
+		ID:                          types.StringUnknown(),
+		GroupType:                   types.StringValue("department"),
+		GroupID:                     types.StringValue("team"),
+		GroupName:                   types.StringValue("Team"),
+		ParentID:                    types.StringValue("root"),
+		LogoURL:                     types.StringNull(),
+		Description:                 types.StringNull(),
+		MakeFirstUserAdmin:          types.BoolValue(false),
+		MemberProfileVisibility:     types.StringValue("full"),
+		NoneMemberProfileVisibility: types.StringValue("none"),
+		CustomFields:                types.MapNull(types.StringType),
+		CreatedAt:                   types.StringUnknown(),
+		UpdatedAt:                   types.StringUnknown(),
+		Timeouts:                    groupTimeouts,
+	})
+	if diags.HasError() {
+		t.Fatalf("Failed to set plan: %v", diags)
+	}
+
+	identitySchema := fwresource.IdentitySchemaResponse{}
+	r.(fwresource.ResourceWithIdentity).IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, &identitySchema)
+	resp := fwresource.CreateResponse{
+		State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw.Copy()},
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: identitySchema.IdentitySchema,
+			Raw:    tftypes.NewValue(identitySchema.IdentitySchema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &resp)
+	return resp
+}
+
+func TestWaitForConsistency_UserGroup(t *testing.T) {
+	var reads atomic.Int32
+	resp := createUserGroup(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			_, _ = w.Write([]byte(testGroup))
+			return
+		}
+		// the group is not replicated on the first read and stale on the second
+		switch reads.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"success":false,"status":404}`))
+		case 2:
+			_, _ = w.Write([]byte(testStaleGroup))
+		default:
+			_, _ = w.Write([]byte(testGroup))
+		}
+	}, nullTimeouts())
+
+	if resp.Diagnostics.ErrorsCount() > 0 || resp.Diagnostics.WarningsCount() > 0 {
+		t.Fatalf("Expected no errors or warnings, got %v", resp.Diagnostics)
+	}
+	if reads.Load() != 3 {
+		t.Errorf("Expected the group to be read until it is consistent with 3 reads, got %d", reads.Load())
+	}
+}
+
+// the wait ends before the create timeout, the created group is saved with a warning
+func TestWaitForConsistency_OperationTimeout(t *testing.T) {
+	start := time.Now()
+	resp := createUserGroup(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			_, _ = w.Write([]byte(testGroup))
+			return
+		}
+		_, _ = w.Write([]byte(testStaleGroup))
+	}, timeouts.Value{Object: types.ObjectValueMust(
+		map[string]attr.Type{"create": types.StringType, "read": types.StringType, "update": types.StringType, "delete": types.StringType},
+		map[string]attr.Value{
+			"create": types.StringValue("1s"),
+			"read":   types.StringNull(),
+			"update": types.StringNull(),
+			"delete": types.StringNull(),
+		},
+	)})
+
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Errorf("Expected the wait to end before the create timeout, took %s", elapsed)
+	}
+	if resp.Diagnostics.ErrorsCount() > 0 || resp.Diagnostics.WarningsCount() != 1 {
+		t.Fatalf("Expected only a warning for the inconsistent group, got %v", resp.Diagnostics)
+	}
+	var groupID types.String
+	resp.State.GetAttribute(context.Background(), path.Root("id"), &groupID)
+	if groupID.ValueString() != "id" {
+		t.Errorf("Expected the created group to be saved, got id %s", groupID)
+	}
+}
+
+const (
+	testApp      = `{"success":true,"data":{"_id":"id","client_id":"client","client_name":"App","allowed_scopes":["openid","profile"]}}`
+	testStaleApp = `{"success":true,"data":{"_id":"id","client_id":"client","client_name":"App","allowed_scopes":["openid"]}}`
+)
+
+// an update which keeps the name of the app is waited for until the written scopes are read
+func TestWaitForConsistency_AppUpdate(t *testing.T) {
+	ctx := context.Background()
+	var reads atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPut {
+			_, _ = w.Write([]byte(testApp))
+			return
+		}
+		if reads.Add(1) == 1 {
+			_, _ = w.Write([]byte(testStaleApp))
+			return
+		}
+		_, _ = w.Write([]byte(testApp))
+	}))
+	defer server.Close()
+
+	r := resources.NewAppResource()
+	client := &cidaas.Client{Apps: cidaas.NewApp(cidaas.ClientConfig{BaseURL: server.URL, AccessToken: "test-token"})}
+	configureResp := fwresource.ConfigureResponse{}
+	r.(fwresource.ResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{ProviderData: client}, &configureResp)
+
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	// all attributes are null except the ones set below
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	nulls := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		nulls[name] = tftypes.NewValue(attrType, nil)
+	}
+	appWithScopes := func(scopes ...string) tftypes.Value {
+		values := map[string]tftypes.Value{}
+		for name, value := range nulls {
+			values[name] = value
+		}
+		elements := make([]tftypes.Value, 0, len(scopes))
+		for _, scope := range scopes {
+			elements = append(elements, tftypes.NewValue(tftypes.String, scope))
+		}
+		values["id"] = tftypes.NewValue(tftypes.String, "id")
+		values["client_id"] = tftypes.NewValue(tftypes.String, "client")
+		values["client_name"] = tftypes.NewValue(tftypes.String, "App")
+		values["allowed_scopes"] = tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, elements)
+		return tftypes.NewValue(objectType, values)
+	}
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: appWithScopes("openid", "profile")}
+	identitySchema := fwresource.IdentitySchemaResponse{}
+	r.(fwresource.ResourceWithIdentity).IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, &identitySchema)
+	resp := fwresource.UpdateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: plan.Raw.Copy()},
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: identitySchema.IdentitySchema,
+			Raw:    tftypes.NewValue(identitySchema.IdentitySchema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.Update(ctx, fwresource.UpdateRequest{
+		Plan:   plan,
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw.Copy()},
+		State:  tfsdk.State{Schema: schemaResp.Schema, Raw: appWithScopes("openid")},
+	}, &resp)
+
+	if resp.Diagnostics.ErrorsCount() > 0 || resp.Diagnostics.WarningsCount() > 0 {
+		t.Fatalf("Expected no errors or warnings, got %v", resp.Diagnostics)
+	}
+	if reads.Load() != 2 {
+		t.Errorf("Expected the app to be read until the written scopes are returned with 2 reads, got %d", reads.Load())
+	}
+}
@@ -7,8 +7,10 @@ import (
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -22,8 +24,22 @@ func NewAppResource() resource.Resource {
 	return &AppResource{
 		BaseResource: NewBaseResource(
 			BaseResourceConfig{
-				Name:   RESOURCE_APP,
-				Schema: &resourceAppSchema,
+				Name:    RESOURCE_APP,
+				Schema:  &resourceAppSchema,
+				Version: 1,
+				StateUpgraders: map[int64]resource.StateUpgrader{
+					// 3.3.0 removed common_configs and 3.4.7 removed the unsupported attributes.
+					// is_group_login_selection_enabled was removed in 3.4.7 as well but added again in 3.4.9.
+					0: removedAttributesUpgrader(
+						"common_configs",
+						"always_ask_mfa",
+						"editable",
+						"email_verification_required",
+						"enable_classical_provider",
+						"fds_enabled",
+						"mobile_number_verification_required",
+					),
+				},
 			},
 		),
 	}
@@ -69,7 +85,25 @@ func (r *AppResource) ValidateConfig(ctx context.Context, req resource.ValidateC
 	}
 }
 
+type AppIdentity struct {
+	ClientID types.String `tfsdk:"client_id"`
+}
+
+func (r *AppResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"client_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The client ID of the app.",
+			},
+		},
+	}
+}
+
 func (r *AppResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
 	var plan AppConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(plan.ExtractAppConfigs(ctx)...)
@@ -102,12 +136,15 @@ func (r *AppResource) Create(ctx context.Context, req resource.CreateRequest, re
 		"client_id": res.Data.ClientID,
 	})
 
+	r.waitForApp(ctx, res.Data.ClientID, *appModel, &resp.Diagnostics)
+
 	plan.ID = util.StringValueOrNull(&res.Data.ID)
 	plan.ClientID = util.StringValueOrNull(&res.Data.ClientID)
 	plan.ClientSecret = util.StringValueOrNull(&res.Data.ClientSecret)
 
 	// Set the updated state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, AppIdentity{ClientID: plan.ClientID})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -122,6 +159,9 @@ func (r *AppResource) Create(ctx context.Context, req resource.CreateRequest, re
 }
 
 func (r *AppResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
 	var state AppConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	resp.Diagnostics.Append(state.ExtractAppConfigs(ctx)...)
@@ -146,6 +186,7 @@ func (r *AppResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	updateAppState(&state, *data, isImport)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, AppIdentity{ClientID: state.ClientID})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -160,6 +201,9 @@ func (r *AppResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 }
 
 func (r *AppResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
 	var plan, state, config AppConfig
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -200,8 +244,10 @@ func (r *AppResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	tflog.Info(ctx, "successfully updated app via API", util.H{
 		"client_id": state.ClientID.ValueString(),
 	})
+	r.waitForApp(ctx, state.ClientID.ValueString(), *appModel, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, AppIdentity{ClientID: plan.ClientID})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state after update", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -212,6 +258,9 @@ func (r *AppResource) Update(ctx context.Context, req resource.UpdateRequest, re
 }
 
 func (r *AppResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
 	var state AppConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -236,8 +285,23 @@ func (r *AppResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	})
 }
 
+// waitForApp re-reads the app after a write until cidaas returns it with the written name, scopes, urls and app group.
+// An update usually keeps the name, so the name alone does not tell a stale read apart.
+func (r *AppResource) waitForApp(ctx context.Context, clientID string, app cidaas.AppModel, diags *diag.Diagnostics) {
+	waitForConsistency(ctx, "app "+clientID, func(ctx context.Context) (*cidaas.AppResponse, error) {
+		return r.cidaasClient.Apps.Get(ctx, clientID)
+	}, func(res *cidaas.AppResponse) bool {
+		return res.Data.ClientID == clientID &&
+			res.Data.ClientName == app.ClientName &&
+			sameElements(res.Data.AllowedScopes, app.AllowedScopes) &&
+			sameElements(res.Data.RedirectURIS, app.RedirectURIS) &&
+			sameElements(res.Data.AllowedLogoutUrls, app.AllowedLogoutUrls) &&
+			(app.ClientGroupID == "" || res.Data.ClientGroupID == app.ClientGroupID)
+	}, diags)
+}
+
 func (r *AppResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("client_id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("client_id"), path.Root("client_id"), req, resp)
 }
 
 // updateAppState updates the Terraform state with data from the API response.
@@ -281,6 +345,9 @@ func updateAppState(state *AppConfig, resp cidaas.AppResponse, isImport bool) {
 	if !state.TemplateGroupID.IsNull() || isImport {
 		state.TemplateGroupID = util.StringValueOrNull(&data.TemplateGroupID)
 	}
+	if !state.ClientGroupID.IsNull() || isImport {
+		state.ClientGroupID = util.StringValueOrNull(&data.ClientGroupID)
+	}
 	if !state.LogoAlign.IsNull() || isImport {
 		state.LogoAlign = util.StringValueOrNull(&data.LogoAlign)
 	}
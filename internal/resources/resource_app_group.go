@@ -0,0 +1,302 @@
+package resources
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type AppGroupResource struct {
+	BaseResource
+}
+
+func NewAppGroupResource() resource.Resource {
+	return &AppGroupResource{
+		BaseResource: NewBaseResource(
+			BaseResourceConfig{
+				Name:   RESOURCE_APP_GROUP,
+				Schema: &appGroupSchema,
+			},
+		),
+	}
+}
+
+type AppGroupConfig struct {
+	ID              types.String   `tfsdk:"id"`
+	ClientGroupID   types.String   `tfsdk:"client_group_id"`
+	GroupName       types.String   `tfsdk:"group_name"`
+	Description     types.String   `tfsdk:"description"`
+	TemplateGroupID types.String   `tfsdk:"template_group_id"`
+	HostedPageGroup types.String   `tfsdk:"hosted_page_group"`
+	CreatedAt       types.String   `tfsdk:"created_at"`
+	UpdatedAt       types.String   `tfsdk:"updated_at"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+var appGroupSchema = schema.Schema{
+	MarkdownDescription: "The `cidaas_app_group` resource manages app groups in cidaas. App groups organise apps that share" +
+		" branding and policies. An app is assigned to a group with the `client_group_id` attribute of the `cidaas_app` resource." +
+		"\n\n Ensure that the below scopes are assigned to the client with the specified `client_id`:" +
+		"\n- cidaas:apps_read" +
+		"\n- cidaas:apps_write" +
+		"\n- cidaas:apps_delete",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the app group resource.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"client_group_id": schema.StringAttribute{
+			Required: true,
+			MarkdownDescription: "The unique identifier of the app group. It is used to assign apps to the group and to import an existing app group." +
+				" It cannot be updated for an existing state.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.RegexMatches(
+					regexp.MustCompile(`^[a-zA-Z0-9_-]+$`),
+					"must contain only alphanumeric characters, underscores and hyphens",
+				),
+			},
+			PlanModifiers: []planmodifier.String{
+				&validators.UniqueIdentifier{},
+			},
+		},
+		"group_name": schema.StringAttribute{
+			Required:    true,
+			Description: "The display name of the app group.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"description": schema.StringAttribute{
+			Optional:    true,
+			Description: "The description of the app group.",
+		},
+		"template_group_id": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "The id of the template group shared by the apps of the group, for example the `group_id` of a `cidaas_template_group` resource.",
+		},
+		"hosted_page_group": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "The hosted page group shared by the apps of the group, for example the `hosted_page_group_name` of a `cidaas_hosted_page` resource.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "The timestamp when the resource was created.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "The timestamp when the resource was last updated.",
+		},
+	},
+}
+
+type AppGroupIdentity struct {
+	ClientGroupID types.String `tfsdk:"client_group_id"`
+}
+
+func (r *AppGroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"client_group_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique identifier of the app group.",
+			},
+		},
+	}
+}
+
+func (r *AppGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
+	var plan AppGroupConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get plan data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	res, err := r.cidaasClient.AppGroups.Create(ctx, prepareAppGroupModel(plan))
+	if err != nil {
+		tflog.Error(ctx, "failed to create app group via API", util.H{
+			"client_group_id": plan.ClientGroupID.ValueString(),
+			"error":           err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to create app group", util.FormatErrorMessage(err))
+		return
+	}
+	tflog.Info(ctx, "successfully created app group via API", util.H{
+		"client_group_id": res.Data.ClientGroupID,
+	})
+
+	plan.ID = util.StringValueOrNull(&res.Data.ID)
+	plan.CreatedAt = util.StringValueOrNull(&res.Data.CreatedTime)
+	plan.UpdatedAt = util.StringValueOrNull(&res.Data.UpdatedTime)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, AppGroupIdentity{ClientGroupID: plan.ClientGroupID})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "resource app_group created successfully", util.H{
+		"client_group_id": plan.ClientGroupID.ValueString(),
+	})
+}
+
+func (r *AppGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
+	var state AppGroupConfig
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get state data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	res, err := r.cidaasClient.AppGroups.Get(ctx, state.ClientGroupID.ValueString())
+	if err != nil {
+		tflog.Error(ctx, "failed to read app group via API", util.H{
+			"client_group_id": state.ClientGroupID.ValueString(),
+			"error":           err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to read app group", util.FormatErrorMessage(err))
+		return
+	}
+
+	state.ID = util.StringValueOrNull(&res.Data.ID)
+	state.ClientGroupID = util.StringValueOrNull(&res.Data.ClientGroupID)
+	state.GroupName = util.StringValueOrNull(&res.Data.GroupName)
+	state.Description = util.StringValueOrNull(&res.Data.Description)
+	state.TemplateGroupID = util.StringValueOrNull(&res.Data.TemplateGroupID)
+	state.HostedPageGroup = util.StringValueOrNull(&res.Data.HostedPageGroup)
+	state.CreatedAt = util.StringValueOrNull(&res.Data.CreatedTime)
+	state.UpdatedAt = util.StringValueOrNull(&res.Data.UpdatedTime)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, AppGroupIdentity{ClientGroupID: state.ClientGroupID})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Debug(ctx, "resource app_group read successfully", util.H{
+		"client_group_id": res.Data.ClientGroupID,
+	})
+}
+
+func (r *AppGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
+	var plan, state AppGroupConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get plan or state data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	appGroup := prepareAppGroupModel(plan)
+	appGroup.ID = state.ID.ValueString()
+	res, err := r.cidaasClient.AppGroups.Update(ctx, appGroup)
+	if err != nil {
+		tflog.Error(ctx, "failed to update app group via API", util.H{
+			"client_group_id": appGroup.ClientGroupID,
+			"error":           err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to update app group", util.FormatErrorMessage(err))
+		return
+	}
+	tflog.Info(ctx, "successfully updated app group via API", util.H{
+		"client_group_id": appGroup.ClientGroupID,
+	})
+
+	plan.UpdatedAt = util.StringValueOrNull(&res.Data.UpdatedTime)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, AppGroupIdentity{ClientGroupID: plan.ClientGroupID})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state after update", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Debug(ctx, "resource app_group updated successfully", util.H{
+		"client_group_id": appGroup.ClientGroupID,
+	})
+}
+
+func (r *AppGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
+	var state AppGroupConfig
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get state data for deletion", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	err := r.cidaasClient.AppGroups.Delete(ctx, state.ClientGroupID.ValueString())
+	if err != nil {
+		tflog.Error(ctx, "failed to delete app group via API", util.H{
+			"client_group_id": state.ClientGroupID.ValueString(),
+			"error":           err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to delete app group", util.FormatErrorMessage(err))
+		return
+	}
+
+	tflog.Info(ctx, "resource app_group deleted successfully", util.H{
+		"client_group_id": state.ClientGroupID.ValueString(),
+	})
+}
+
+func (r *AppGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("client_group_id"), path.Root("client_group_id"), req, resp)
+}
+
+func prepareAppGroupModel(plan AppGroupConfig) cidaas.AppGroupModel {
+	return cidaas.AppGroupModel{
+		ClientGroupID:   plan.ClientGroupID.ValueString(),
+		GroupName:       plan.GroupName.ValueString(),
+		Description:     plan.Description.ValueString(),
+		TemplateGroupID: plan.TemplateGroupID.ValueString(),
+		HostedPageGroup: plan.HostedPageGroup.ValueString(),
+	}
+}
@@ -0,0 +1,115 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	acctest "github.com/Cidaas/terraform-provider-cidaas/internal/test"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// create, read, import and update test
+func TestAccAppGroupResource_Basic(t *testing.T) {
+	t.Parallel()
+
+	clientGroupID := acctest.RandString(10)
+	testResourceID := acctest.RandString(10)
+	testResourceName := fmt.Sprintf("%s.%s", resources.RESOURCE_APP_GROUP, testResourceID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckAppGroupDestroyed(testResourceName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppGroupResourceConfig(testResourceID, clientGroupID, "Partner Apps"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "client_group_id", clientGroupID),
+					resource.TestCheckResourceAttr(testResourceName, "group_name", "Partner Apps"),
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "created_at"),
+				),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           clientGroupID,
+				ImportStateVerifyIgnore: []string{"created_at", "updated_at"},
+			},
+			{
+				Config: testAccAppGroupResourceConfig(testResourceID, clientGroupID, "Updated Partner Apps"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "group_name", "Updated Partner Apps"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAppGroupResourceConfig(resourceID, clientGroupID, groupName string) string {
+	return fmt.Sprintf(`
+		provider "cidaas" {
+			base_url = "%s"
+		}
+		resource "cidaas_app_group" "%s" {
+			client_group_id = "%s"
+			group_name      = "%s"
+			description     = "app group description"
+		}
+	`, acctest.GetBaseURL(), resourceID, clientGroupID, groupName)
+}
+
+func testCheckAppGroupDestroyed(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+
+		appGroup := cidaas.AppGroup{
+			ClientConfig: cidaas.ClientConfig{
+				BaseURL:     acctest.GetBaseURL(),
+				AccessToken: acctest.TestToken,
+			},
+		}
+		res, err := appGroup.Get(context.Background(), rs.Primary.Attributes["client_group_id"])
+		if err != nil {
+			if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") {
+				return nil
+			}
+			return fmt.Errorf("error checking if app group exists: %w", err)
+		}
+		if res != nil && res.Data.ClientGroupID != "" {
+			return fmt.Errorf("app group %s still exists", res.Data.ClientGroupID)
+		}
+		return nil
+	}
+}
+
+// client_group_id cannot be updated for an existing state
+func TestAccAppGroupResource_ClientGroupIDUpdateFail(t *testing.T) {
+	t.Parallel()
+
+	testResourceID := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppGroupResourceConfig(testResourceID, acctest.RandString(10), "Partner Apps"),
+			},
+			{
+				Config:      testAccAppGroupResourceConfig(testResourceID, acctest.RandString(10), "Partner Apps"),
+				ExpectError: regexp.MustCompile("Unexpected Resource Configuration"),
+			},
+		},
+	})
+}
@@ -2,7 +2,6 @@ package resources_test
 
 import (
 	"fmt"
-	"os"
 	"testing"
 
 	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
@@ -57,7 +56,7 @@ func testAppConfig(clientName, companyWebsite string) string {
       response_types      = ["code"]
       grant_types         = ["authorization_code", "implicit", "refresh_token"]
     }`,
-		os.Getenv("BASE_URL"),
+		acctest.GetBaseURL(),
 		clientName,
 		clientName,
 		companyWebsite,
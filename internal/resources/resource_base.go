@@ -2,37 +2,88 @@ package resources
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
+	"strings"
+	"time"
 
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // nolint:revive
 const (
-	RESOURCE_APP                = "cidaas_app"                // nolint:stylecheck
-	RESOURCE_CONSENT_GROUP      = "cidaas_consent_group"      // nolint:stylecheck
-	RESOURCE_CONSENT_VERSION    = "cidaas_consent_version"    // nolint:stylecheck
-	RESOURCE_CONSENT            = "cidaas_consent"            // nolint:stylecheck
-	RESOURCE_CUSTOM_PROVIDER    = "cidaas_custom_provider"    // nolint:stylecheck
-	RESOURCE_GROUP_TYPE         = "cidaas_group_type"         // nolint:stylecheck
-	RESOURCE_HOSTED_PAGE        = "cidaas_hosted_page"        // nolint:stylecheck
-	RESOURCE_PASSWORD_POLICY    = "cidaas_password_policy"    // nolint:stylecheck
-	RESOURCE_REGISTRATION_FIELD = "cidaas_registration_field" // nolint:stylecheck
-	RESOURCE_ROLE               = "cidaas_role"               // nolint:stylecheck
-	RESOURCE_SCOPE_GROUP        = "cidaas_scope_group"        // nolint:stylecheck
-	RESOURCE_SCOPE              = "cidaas_scope"              // nolint:stylecheck
-	RESOURCE_SOCIAL_PROVIDER    = "cidaas_social_provider"    // nolint:stylecheck
-	RESOURCE_TEMPLATE_GROUP     = "cidaas_template_group"     // nolint:stylecheck
-	RESOURCE_TEMPLATE           = "cidaas_template"           // nolint:stylecheck
-	RESOURCE_USER_GROUP         = "cidaas_user_groups"        // nolint:stylecheck
-	RESOURCE_WEBHOOK            = "cidaas_webhook"            // nolint:stylecheck
+	RESOURCE_APP                      = "cidaas_app"                      // nolint:stylecheck
+	RESOURCE_APP_GROUP                = "cidaas_app_group"                // nolint:stylecheck
+	RESOURCE_CONSENT_GROUP            = "cidaas_consent_group"            // nolint:stylecheck
+	RESOURCE_CONSENT_VERSION          = "cidaas_consent_version"          // nolint:stylecheck
+	RESOURCE_CONSENT                  = "cidaas_consent"                  // nolint:stylecheck
+	RESOURCE_CONSENT_POLICY           = "cidaas_consent_policy"           // nolint:stylecheck
+	RESOURCE_CUSTOM_PROVIDER          = "cidaas_custom_provider"          // nolint:stylecheck
+	RESOURCE_GROUP_CUSTOM_FIELD       = "cidaas_group_custom_field"       // nolint:stylecheck
+	RESOURCE_GROUP_TYPE               = "cidaas_group_type"               // nolint:stylecheck
+	RESOURCE_HOSTED_PAGE              = "cidaas_hosted_page"              // nolint:stylecheck
+	RESOURCE_PASSWORD_POLICY          = "cidaas_password_policy"          // nolint:stylecheck
+	RESOURCE_REGISTRATION_FIELD       = "cidaas_registration_field"       // nolint:stylecheck
+	RESOURCE_REGISTRATION_FIELD_ORDER = "cidaas_registration_field_order" // nolint:stylecheck
+	RESOURCE_ROLE                     = "cidaas_role"                     // nolint:stylecheck
+	RESOURCE_ROLE_MEMBERS             = "cidaas_role_members"             // nolint:stylecheck
+	RESOURCE_SCOPE_GROUP              = "cidaas_scope_group"              // nolint:stylecheck
+	RESOURCE_SCOPE                    = "cidaas_scope"                    // nolint:stylecheck
+	RESOURCE_SOCIAL_PROVIDER          = "cidaas_social_provider"          // nolint:stylecheck
+	RESOURCE_TEMPLATE_GROUP           = "cidaas_template_group"           // nolint:stylecheck
+	RESOURCE_TEMPLATE_SET             = "cidaas_template_set"             // nolint:stylecheck
+	RESOURCE_TEMPLATE                 = "cidaas_template"                 // nolint:stylecheck
+	RESOURCE_TENANT_SETTINGS          = "cidaas_tenant_settings"          // nolint:stylecheck
+	RESOURCE_USER_GROUP               = "cidaas_user_group"               // nolint:stylecheck
+	RESOURCE_USER_GROUPS              = "cidaas_user_groups"              // nolint:stylecheck // deprecated, replaced by RESOURCE_USER_GROUP
+	RESOURCE_USER_GROUP_MEMBERSHIP    = "cidaas_user_group_membership"    // nolint:stylecheck
+	RESOURCE_USER                     = "cidaas_user"                     // nolint:stylecheck
+	RESOURCE_USER_ROLE                = "cidaas_user_role"                // nolint:stylecheck
+	RESOURCE_WEBHOOK                  = "cidaas_webhook"                  // nolint:stylecheck
 )
 
 type BaseResourceConfig struct {
 	Name   string
 	Schema *schema.Schema
+	// Version is the version of the schema. It must be incremented with every schema change which requires
+	// the existing state to be migrated, e.g. a removed or renamed attribute or a changed attribute type.
+	// Attributes which are only added do not require a new version.
+	Version int64
+	// StateUpgraders upgrade the state of every prior version directly to the current Version. When Version
+	// is incremented, an upgrader for the previous version is added and the existing upgraders are adapted.
+	// Every upgrader is tested with a state fixture in testdata/state, see TestUpgradeState_Fixtures.
+	StateUpgraders map[int64]resource.StateUpgrader
+	// Timeouts overrides the default timeouts of the operations of the resource.
+	// Operations without a timeout use the value of defaultTimeouts.
+	Timeouts OperationTimeouts
+}
+
+// OperationTimeouts are the durations an operation of a resource may take before its context is cancelled.
+type OperationTimeouts struct {
+	Create time.Duration
+	Read   time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+var defaultTimeouts = OperationTimeouts{
+	Create: 10 * time.Minute,
+	Read:   5 * time.Minute,
+	Update: 10 * time.Minute,
+	Delete: 10 * time.Minute,
 }
 
 type BaseResource struct {
@@ -87,7 +138,7 @@ func (r *BaseResource) Metadata(
 }
 
 func (r *BaseResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -100,4 +151,192 @@ func (r *BaseResource) Schema(
 		return
 	}
 	resp.Schema = *r.Config.Schema
+	resp.Schema.Version = r.Config.Version
+	resp.Schema.Blocks = maps.Clone(resp.Schema.Blocks)
+	if resp.Schema.Blocks == nil {
+		resp.Schema.Blocks = map[string]schema.Block{}
+	}
+	resp.Schema.Blocks["timeouts"] = r.timeoutsBlock(ctx)
+}
+
+func (r *BaseResource) timeouts() OperationTimeouts {
+	timeouts := r.Config.Timeouts
+	if timeouts.Create == 0 {
+		timeouts.Create = defaultTimeouts.Create
+	}
+	if timeouts.Read == 0 {
+		timeouts.Read = defaultTimeouts.Read
+	}
+	if timeouts.Update == 0 {
+		timeouts.Update = defaultTimeouts.Update
+	}
+	if timeouts.Delete == 0 {
+		timeouts.Delete = defaultTimeouts.Delete
+	}
+	return timeouts
+}
+
+// timeoutsBlock returns the standard timeouts block of terraform-plugin-framework-timeouts with the defaults
+// of the resource in the descriptions.
+func (r *BaseResource) timeoutsBlock(ctx context.Context) schema.Block {
+	defaults := r.timeouts()
+	description := func(operation string, def time.Duration) string {
+		return fmt.Sprintf("The time to wait for the %s of the resource, a duration such as `30s` or `2h45m`."+
+			" Valid time units are `s`, `m` and `h`. Defaults to `%s`.", operation, formatDuration(def))
+	}
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create:            true,
+		Read:              true,
+		Update:            true,
+		Delete:            true,
+		CreateDescription: description("creation", defaults.Create),
+		ReadDescription:   description("read", defaults.Read),
+		UpdateDescription: description("update", defaults.Update),
+		DeleteDescription: description("deletion", defaults.Delete),
+	})
+}
+
+// nullTimeouts returns the timeouts block of a state which is not built from the plan or the prior state.
+func nullTimeouts() timeouts.Value {
+	return timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{
+		"create": types.StringType,
+		"read":   types.StringType,
+		"update": types.StringType,
+		"delete": types.StringType,
+	})}
+}
+
+// formatDuration formats a duration without the zero units of time.Duration.String, e.g. `10m` instead of `10m0s`.
+func formatDuration(d time.Duration) string {
+	switch {
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%dh", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%dm", d/time.Minute)
+	default:
+		return d.String()
+	}
+}
+
+// attributeGetter is implemented by tfsdk.Plan and tfsdk.State.
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+// withTimeout returns the context for the operation (create, read, update or delete) with the deadline of the
+// timeouts block in the plan or state. The returned function must be deferred. It cancels the context and,
+// if a request failed because the deadline was exceeded, replaces its error with one which states the operation.
+// No error is added if the operation completed, even if the deadline expired afterwards.
+func (r *BaseResource) withTimeout(ctx context.Context, data attributeGetter, operation string, diags *diag.Diagnostics) (context.Context, func()) {
+	defaults := r.timeouts()
+	var configured timeouts.Value
+	// the timeouts block is null in states which were imported or written before it was added
+	data.GetAttribute(ctx, path.Root("timeouts"), &configured)
+	var timeout time.Duration
+	switch operation {
+	case "create":
+		timeout, _ = configured.Create(ctx, defaults.Create)
+	case "read":
+		timeout, _ = configured.Read(ctx, defaults.Read)
+	case "update":
+		timeout, _ = configured.Update(ctx, defaults.Update)
+	default:
+		timeout, _ = configured.Delete(ctx, defaults.Delete)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return ctx, func() {
+		defer cancel()
+		if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return
+		}
+		var timedOut bool
+		remaining := make(diag.Diagnostics, 0, len(*diags))
+		for _, d := range *diags {
+			if d.Severity() == diag.SeverityError && strings.Contains(d.Detail(), context.DeadlineExceeded.Error()) {
+				timedOut = true
+				continue
+			}
+			remaining = append(remaining, d)
+		}
+		if !timedOut {
+			return
+		}
+		tflog.Error(ctx, "operation timed out", util.H{
+			"resource":  r.Config.Name,
+			"operation": operation,
+			"timeout":   timeout.String(),
+		})
+		remaining.AddError(
+			fmt.Sprintf("%s %s timed out", r.Config.Name, operation),
+			fmt.Sprintf("The %s operation of %s did not complete within %s."+
+				" Increase timeouts.%s in the resource configuration if cidaas needs more time to respond.",
+				operation, r.Config.Name, timeout, operation),
+		)
+		*diags = remaining
+	}
+}
+
+// UpgradeState returns the state upgraders of the prior schema versions of the resource.
+func (r *BaseResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return r.Config.StateUpgraders
+}
+
+// removedAttributesUpgrader returns the state upgrader of a prior version which differs from the current
+// schema only by removed attributes. The removed attributes are dropped from the raw state and the
+// attributes added since that version are null.
+func removedAttributesUpgrader(removed ...string) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			if req.RawState == nil {
+				resp.Diagnostics.AddError("Unable to Upgrade Resource State", "The prior state is missing.")
+				return
+			}
+			var attributes map[string]json.RawMessage
+			if err := json.Unmarshal(req.RawState.JSON, &attributes); err != nil {
+				resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Failed to parse the prior state: %s", err))
+				return
+			}
+			for _, name := range removed {
+				delete(attributes, name)
+			}
+			for name := range attributes {
+				if _, ok := resp.State.Schema.GetAttributes()[name]; !ok {
+					if _, ok := resp.State.Schema.GetBlocks()[name]; !ok {
+						tflog.Warn(ctx, "dropping unknown attribute from the prior state", util.H{"attribute": name})
+					}
+				}
+			}
+
+			content, err := json.Marshal(attributes)
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Failed to write the upgraded state: %s", err))
+				return
+			}
+			state := tfprotov6.RawState{JSON: content}
+			resp.State.Raw, err = state.UnmarshalWithOpts(resp.State.Schema.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{
+				ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+			})
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("The prior state does not match the current schema: %s", err))
+			}
+		},
+	}
+}
+
+// compositeIdentity is implemented by the identities of resources with an import identifier
+// composed of several attributes, e.g. `template_key:template_type:locale`.
+type compositeIdentity interface {
+	importID() string
+}
+
+// importID returns the import identifier of an import. An import by identity is converted to the
+// import identifier so that both are validated and processed the same way.
+func importID[T compositeIdentity](ctx context.Context, req resource.ImportStateRequest, diags *diag.Diagnostics) string {
+	if req.ID != "" || req.Identity == nil {
+		return req.ID
+	}
+	var identity T
+	diags.Append(req.Identity.Get(ctx, &identity)...)
+	return identity.importID()
 }
@@ -0,0 +1,255 @@
+package resources_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// importState runs the ImportState of the resource with either the import identifier or the identity.
+func importState(t *testing.T, r fwresource.Resource, id string, identity any) fwresource.ImportStateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	identitySchemaResp := fwresource.IdentitySchemaResponse{}
+	r.(fwresource.ResourceWithIdentity).IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, &identitySchemaResp)
+
+	req := fwresource.ImportStateRequest{ID: id}
+	resp := fwresource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: identitySchemaResp.IdentitySchema,
+			Raw:    tftypes.NewValue(identitySchemaResp.IdentitySchema.Type().TerraformType(ctx), nil),
+		},
+	}
+	if identity != nil {
+		req.Identity = &tfsdk.ResourceIdentity{Schema: resp.Identity.Schema, Raw: resp.Identity.Raw.Copy()}
+		if diags := req.Identity.Set(ctx, identity); diags.HasError() {
+			t.Fatalf("Failed to set identity: %v", diags)
+		}
+	}
+	r.(fwresource.ResourceWithImportState).ImportState(ctx, req, &resp)
+	return resp
+}
+
+func stringAttribute(t *testing.T, state tfsdk.State, name string) string {
+	t.Helper()
+	var value types.String
+	if diags := state.GetAttribute(context.Background(), path.Root(name), &value); diags.HasError() {
+		t.Fatalf("Failed to get %s: %v", name, diags)
+	}
+	return value.ValueString()
+}
+
+func TestImportState_ByIdentity(t *testing.T) {
+	testCases := []struct {
+		name     string
+		resource fwresource.Resource
+		identity any
+		expected map[string]string
+	}{
+		{
+			name:     "role",
+			resource: resources.NewRoleResource(),
+			identity: resources.RoleIdentity{Role: types.StringValue("ADMIN")},
+			expected: map[string]string{"id": "ADMIN"},
+		},
+		{
+			name:     "hosted page",
+			resource: resources.NewHostedPageResource(),
+			identity: resources.HostedPageIdentity{HostedPageGroupName: types.StringValue("default")},
+			expected: map[string]string{"id": "default"},
+		},
+		{
+			name:     "social provider",
+			resource: resources.NewSocialProvider(),
+			identity: resources.SocialProviderIdentity{ProviderName: types.StringValue("google"), ID: types.StringValue("provider-id")},
+			expected: map[string]string{"provider_name": "google", "id": "provider-id"},
+		},
+		{
+			name:     "template",
+			resource: resources.NewTemplateResource(),
+			identity: resources.TemplateIdentity{
+				TemplateKey:  types.StringValue("VERIFY_USER"),
+				TemplateType: types.StringValue("EMAIL"),
+				Locale:       types.StringValue("de-de"),
+			},
+			expected: map[string]string{"template_key": "VERIFY_USER", "template_type": "EMAIL", "locale": "de-de"},
+		},
+		{
+			name:     "user role",
+			resource: resources.NewUserRoleResource(),
+			identity: resources.UserRoleIdentity{Role: types.StringValue("cidaas:admin"), Sub: types.StringValue("sub")},
+			expected: map[string]string{"role": "cidaas:admin", "sub": "sub"},
+		},
+		{
+			name:     "consent policy",
+			resource: resources.NewConsentPolicyResource(),
+			identity: resources.ConsentPolicyIdentity{
+				ConsentGroupID: types.StringValue("group-id"),
+				Name:           types.StringValue("newsletter"),
+				Locales:        types.ListValueMust(types.StringType, []attr.Value{types.StringValue("de"), types.StringValue("en")}),
+			},
+			expected: map[string]string{"consent_group_id": "group-id", "name": "newsletter"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := importState(t, tc.resource, "", tc.identity)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected import error: %v", resp.Diagnostics)
+			}
+			for attribute, expected := range tc.expected {
+				if value := stringAttribute(t, resp.State, attribute); value != expected {
+					t.Errorf("Expected %s to be %s, got %s", attribute, expected, value)
+				}
+			}
+		})
+	}
+}
+
+func TestImportState_ByIdentityAndID(t *testing.T) {
+	ctx := context.Background()
+	identity := resources.ConsentPolicyIdentity{
+		ConsentGroupID: types.StringValue("group-id"),
+		Name:           types.StringValue("newsletter"),
+		Locales:        types.ListValueMust(types.StringType, []attr.Value{types.StringValue("de"), types.StringValue("en")}),
+	}
+	byIdentity := importState(t, resources.NewConsentPolicyResource(), "", identity)
+	byID := importState(t, resources.NewConsentPolicyResource(), "group-id:newsletter:de:en", nil)
+	if byIdentity.Diagnostics.HasError() || byID.Diagnostics.HasError() {
+		t.Fatalf("Unexpected import errors: %v %v", byIdentity.Diagnostics, byID.Diagnostics)
+	}
+
+	var identityLocales, idLocales types.Map
+	byIdentity.State.GetAttribute(ctx, path.Root("locales"), &identityLocales)
+	byID.State.GetAttribute(ctx, path.Root("locales"), &idLocales)
+	if len(identityLocales.Elements()) != 2 || !identityLocales.Equal(idLocales) {
+		t.Errorf("Expected the locales de and en for both imports, got %v and %v", identityLocales, idLocales)
+	}
+}
+
+func TestImportState_ByIdentityInvalid(t *testing.T) {
+	// the identity is validated the same way as the import identifier
+	resp := importState(t, resources.NewTemplateResource(), "", resources.TemplateIdentity{
+		TemplateKey:  types.StringValue("verify_user"),
+		TemplateType: types.StringValue("EMAIL"),
+		Locale:       types.StringValue("de-de"),
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error for a template key in lowercase")
+	}
+	if summary := resp.Diagnostics.Errors()[0].Summary(); summary != "Unexpected Import Identifier" {
+		t.Errorf("Expected the error Unexpected Import Identifier, got %s", summary)
+	}
+}
+
+// nullTimeouts is the timeouts block of models which are not read from a plan or state.
+func nullTimeouts() timeouts.Value {
+	return timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{
+		"create": types.StringType,
+		"read":   types.StringType,
+		"update": types.StringType,
+		"delete": types.StringType,
+	})}
+}
+
+func TestTimeouts_Schema(t *testing.T) {
+	ctx := context.Background()
+	resp := fwresource.SchemaResponse{}
+	resources.NewRoleResource().Schema(ctx, fwresource.SchemaRequest{}, &resp)
+
+	block, ok := resp.Schema.Blocks["timeouts"].(schema.SingleNestedBlock)
+	if !ok {
+		t.Fatalf("Expected the timeouts block in the schema, got %v", resp.Schema.Blocks)
+	}
+	for operation, expected := range map[string]string{"create": "10m", "read": "5m", "update": "10m", "delete": "10m"} {
+		attribute, ok := block.Attributes[operation]
+		if !ok {
+			t.Fatalf("Expected the attribute %s in the timeouts block", operation)
+		}
+		if !strings.Contains(attribute.GetDescription(), "Defaults to `"+expected+"`") {
+			t.Errorf("Expected the default %s for %s, got %s", expected, operation, attribute.GetDescription())
+		}
+	}
+
+	// the block is added to a copy, the schema of the resource stays unchanged
+	other := fwresource.SchemaResponse{}
+	resources.NewRoleResource().Schema(ctx, fwresource.SchemaRequest{}, &other)
+	if len(other.Schema.Blocks) != 1 {
+		t.Errorf("Expected only the timeouts block, got %v", other.Schema.Blocks)
+	}
+}
+
+func TestTimeouts_Read(t *testing.T) {
+	ctx := context.Background()
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		// cidaas does not respond until the test has finished
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	r := resources.NewRoleResource()
+	client := &cidaas.Client{Roles: cidaas.NewRole(cidaas.ClientConfig{BaseURL: server.URL, AccessToken: "test-token"})}
+	configureResp := fwresource.ConfigureResponse{}
+	r.(fwresource.ResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{ProviderData: client}, &configureResp)
+
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, resources.Role{
+		ID:   types.StringValue("admin"),
+		Role: types.StringValue("admin"),
+		Timeouts: timeouts.Value{Object: types.ObjectValueMust(
+			map[string]attr.Type{"create": types.StringType, "read": types.StringType, "update": types.StringType, "delete": types.StringType},
+			map[string]attr.Value{
+				"create": types.StringNull(),
+				"read":   types.StringValue("100ms"),
+				"update": types.StringNull(),
+				"delete": types.StringNull(),
+			},
+		)},
+	})
+	if diags.HasError() {
+		t.Fatalf("Failed to set state: %v", diags)
+	}
+
+	start := time.Now()
+	resp := fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, &resp)
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected the read to be cancelled after the timeout, took %s", elapsed)
+	}
+	// the error of the cancelled request is replaced, a timeout is reported once
+	if errs := resp.Diagnostics.Errors(); len(errs) != 1 || errs[0].Summary() != "cidaas_role read timed out" {
+		t.Errorf("Expected a single error which states the read timed out, got %v", errs)
+	}
+}
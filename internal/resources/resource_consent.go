@@ -9,9 +9,11 @@ import (
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
 	"github.com/Cidaas/terraform-provider-cidaas/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -37,12 +39,13 @@ func NewConsentResource() resource.Resource {
 }
 
 type ConsentConfig struct {
-	ID             types.String `tfsdk:"id"`
-	ConsentGroupID types.String `tfsdk:"consent_group_id"`
-	Name           types.String `tfsdk:"name"`
-	Enabled        types.Bool   `tfsdk:"enabled"`
-	CreatedAt      types.String `tfsdk:"created_at"`
-	UpdatedAt      types.String `tfsdk:"updated_at"`
+	ID             types.String   `tfsdk:"id"`
+	ConsentGroupID types.String   `tfsdk:"consent_group_id"`
+	Name           types.String   `tfsdk:"name"`
+	Enabled        types.Bool     `tfsdk:"enabled"`
+	CreatedAt      types.String   `tfsdk:"created_at"`
+	UpdatedAt      types.String   `tfsdk:"updated_at"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
 var consentSchema = schema.Schema{
@@ -99,7 +102,34 @@ var consentSchema = schema.Schema{
 	},
 }
 
+type ConsentIdentity struct {
+	ConsentGroupID types.String `tfsdk:"consent_group_id"`
+	Name           types.String `tfsdk:"name"`
+}
+
+func (i ConsentIdentity) importID() string {
+	return i.ConsentGroupID.ValueString() + ":" + i.Name.ValueString()
+}
+
+func (r *ConsentResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"consent_group_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the consent group of the consent.",
+			},
+			"name": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The name of the consent.",
+			},
+		},
+	}
+}
+
 func (r *ConsentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
 	var plan ConsentConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -132,6 +162,7 @@ func (r *ConsentResource) Create(ctx context.Context, req resource.CreateRequest
 	plan.UpdatedAt = util.StringValueOrNull(&res.Data.UpdatedTime)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ConsentIdentity{ConsentGroupID: plan.ConsentGroupID, Name: plan.Name})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -143,6 +174,9 @@ func (r *ConsentResource) Create(ctx context.Context, req resource.CreateRequest
 }
 
 func (r *ConsentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
 	var state ConsentConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -201,6 +235,7 @@ func (r *ConsentResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ConsentIdentity{ConsentGroupID: state.ConsentGroupID, Name: state.Name})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "Failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -212,6 +247,9 @@ func (r *ConsentResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *ConsentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
 	var plan, state ConsentConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -243,6 +281,7 @@ func (r *ConsentResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	plan.UpdatedAt = util.StringValueOrNull(&res.Data.UpdatedTime)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ConsentIdentity{ConsentGroupID: plan.ConsentGroupID, Name: plan.Name})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state after update", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -253,6 +292,9 @@ func (r *ConsentResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *ConsentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
 	var state ConsentConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -277,7 +319,10 @@ func (r *ConsentResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *ConsentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id := req.ID
+	id := importID[ConsentIdentity](ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Debug(ctx, "processing import identifier", util.H{
 		"import_id": id,
 	})
@@ -296,3 +341,13 @@ func (r *ConsentResource) ImportState(ctx context.Context, req resource.ImportSt
 	resp.State.SetAttribute(ctx, path.Root("consent_group_id"), parts[0])
 	resp.State.SetAttribute(ctx, path.Root("name"), parts[1])
 }
+
+// ConsentImportID returns the import identifier of a consent in the format consent_group_id:name.
+func ConsentImportID(consentGroupID, name string) (string, error) {
+	for attribute, value := range map[string]string{"consent_group_id": consentGroupID, "name": name} {
+		if value == "" || strings.Contains(value, ":") {
+			return "", fmt.Errorf("Invalid %s provided in import identifier. The %s must not be empty or contain ':', got: %s", attribute, attribute, value)
+		}
+	}
+	return consentGroupID + ":" + name, nil
+}
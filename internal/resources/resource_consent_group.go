@@ -7,9 +7,11 @@ import (
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
 	"github.com/Cidaas/terraform-provider-cidaas/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -34,11 +36,12 @@ func NewConsentGroupResource() resource.Resource {
 }
 
 type ConsentGroupConfig struct {
-	ID          types.String `tfsdk:"id"`
-	GroupName   types.String `tfsdk:"group_name"`
-	Description types.String `tfsdk:"description"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
+	ID          types.String   `tfsdk:"id"`
+	GroupName   types.String   `tfsdk:"group_name"`
+	Description types.String   `tfsdk:"description"`
+	CreatedAt   types.String   `tfsdk:"created_at"`
+	UpdatedAt   types.String   `tfsdk:"updated_at"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 var consentGroupSchema = schema.Schema{
@@ -84,7 +87,25 @@ var consentGroupSchema = schema.Schema{
 	},
 }
 
+type ConsentGroupIdentity struct {
+	ConsentGroupID types.String `tfsdk:"consent_group_id"`
+}
+
+func (r *ConsentGroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"consent_group_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique identifier of the consent group.",
+			},
+		},
+	}
+}
+
 func (r *ConsentGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
 	var plan ScopeGroupConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -116,6 +137,7 @@ func (r *ConsentGroupResource) Create(ctx context.Context, req resource.CreateRe
 	plan.UpdatedAt = util.StringValueOrNull(&res.Data.UpdatedTime)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ConsentGroupIdentity{ConsentGroupID: plan.ID})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -129,6 +151,9 @@ func (r *ConsentGroupResource) Create(ctx context.Context, req resource.CreateRe
 }
 
 func (r *ConsentGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { //nolint:dupl
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
 	var state ConsentGroupConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -156,6 +181,7 @@ func (r *ConsentGroupResource) Read(ctx context.Context, req resource.ReadReques
 	state.Description = util.StringValueOrNull(&res.Data.Description)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ConsentGroupIdentity{ConsentGroupID: state.ID})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -167,6 +193,9 @@ func (r *ConsentGroupResource) Read(ctx context.Context, req resource.ReadReques
 }
 
 func (r *ConsentGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
 	var plan ConsentGroupConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -190,6 +219,7 @@ func (r *ConsentGroupResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 	plan.UpdatedAt = util.StringValueOrNull(&res.Data.UpdatedTime)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ConsentGroupIdentity{ConsentGroupID: plan.ID})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state after update", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -203,6 +233,9 @@ func (r *ConsentGroupResource) Update(ctx context.Context, req resource.UpdateRe
 }
 
 func (r *ConsentGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
 	var state ConsentGroupConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -226,5 +259,5 @@ func (r *ConsentGroupResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *ConsentGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("consent_group_id"), req, resp)
 }
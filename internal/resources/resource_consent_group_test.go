@@ -3,7 +3,6 @@ package resources_test
 import (
 	"context"
 	"fmt"
-	"os"
 	"regexp"
 	"strings"
 	"testing"
@@ -81,7 +80,7 @@ func testCheckConsentGroupDestroyed(resourceName string) resource.TestCheckFunc
 
 		consentGroup := cidaas.ConsentGroup{
 			ClientConfig: cidaas.ClientConfig{
-				BaseURL:     os.Getenv("BASE_URL"),
+				BaseURL:     acctest.GetBaseURL(),
 				AccessToken: acctest.TestToken,
 			},
 		}
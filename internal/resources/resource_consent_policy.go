@@ -0,0 +1,815 @@
+package resources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type ConsentPolicyResource struct {
+	BaseResource
+}
+
+func NewConsentPolicyResource() resource.Resource {
+	return &ConsentPolicyResource{
+		BaseResource: NewBaseResource(
+			BaseResourceConfig{
+				Name:   RESOURCE_CONSENT_POLICY,
+				Schema: &consentPolicySchema,
+			},
+		),
+	}
+}
+
+type ConsentPolicyConfig struct {
+	ID               types.String   `tfsdk:"id"`
+	ConsentGroupID   types.String   `tfsdk:"consent_group_id"`
+	Name             types.String   `tfsdk:"name"`
+	Enabled          types.Bool     `tfsdk:"enabled"`
+	ConsentType      types.String   `tfsdk:"consent_type"`
+	Scopes           types.Set      `tfsdk:"scopes"`
+	RequiredFields   types.Set      `tfsdk:"required_fields"`
+	Locales          types.Map      `tfsdk:"locales"`
+	ContentHash      types.String   `tfsdk:"content_hash"`
+	CurrentVersion   types.Float64  `tfsdk:"current_version"`
+	CurrentVersionID types.String   `tfsdk:"current_version_id"`
+	Versions         types.List     `tfsdk:"versions"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+type ConsentPolicyLocale struct {
+	Content types.String `tfsdk:"content"`
+	URL     types.String `tfsdk:"url"`
+}
+
+var consentPolicyLocaleType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"content": types.StringType,
+		"url":     types.StringType,
+	},
+}
+
+var consentPolicyVersionType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":         types.StringType,
+		"version":    types.Float64Type,
+		"created_at": types.StringType,
+	},
+}
+
+var consentPolicySchema = schema.Schema{
+	MarkdownDescription: "The `cidaas_consent_policy` resource manages a consent together with its current consent version." +
+		" A new consent version is created whenever the `consent_type`, `scopes`, `required_fields` or the content or url of any locale changes," +
+		" the change is detected with the computed `content_hash`. Previous versions are kept in cidaas and listed read-only in `versions`." +
+		" Use `current_version_id` or `id` to reference the consent, for example in the `consent_refs` of a `cidaas_app`." +
+		"\n\n The resource must not be used together with the `cidaas_consent` and `cidaas_consent_version` resources for the same consent." +
+		" Destroying the resource deletes the consent including all its versions." +
+		"\n\n Ensure that the below scopes are assigned to the client with the specified `client_id`:" +
+		"\n- cidaas:tenant_consent_read" +
+		"\n- cidaas:tenant_consent_write" +
+		"\n- cidaas:tenant_consent_delete",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The unique identifier of the consent.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"consent_group_id": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "The `consent_group_id` to which the consent belongs. It cannot be updated for an existing state.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				&validators.UniqueIdentifier{},
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "The name of the consent. It cannot be updated for an existing state.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				&validators.UniqueIdentifier{},
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "The flag to enable or disable the consent. By default, the value is set to `true`. Changing it does not create a new version.",
+			Default:             booldefault.StaticBool(true),
+		},
+		"consent_type": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Specifies the type of consent. The allowed values are `SCOPES` or `URL`.",
+			Validators: []validator.String{
+				stringvalidator.OneOf(SCOPES, URL),
+			},
+		},
+		"scopes": schema.SetAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			MarkdownDescription: "A set of scopes related to the consent." +
+				"\nNote that the attribute `scopes` is required only if the `consent_type` is set to **SCOPES**.",
+		},
+		"required_fields": schema.SetAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			MarkdownDescription: "A set of fields that are required for the consent." +
+				"\nNote that the attribute `required_fields` is required only if the `consent_type` is set to **SCOPES**.",
+		},
+		"locales": schema.MapNestedAttribute{
+			Required:            true,
+			MarkdownDescription: "The locales of the current consent version, keyed by locale e.g. `en-us`, `de`.",
+			Validators: []validator.Map{
+				mapvalidator.SizeAtLeast(1),
+				mapvalidator.KeysAre(
+					stringvalidator.OneOf(
+						func() []string {
+							validLocals := make([]string, len(util.Locales))
+							for i, locale := range util.Locales {
+								validLocals[i] = strings.ToLower(locale.LocaleString)
+							}
+							return validLocals
+						}()...),
+				),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"content": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The content of the consent in the locale.",
+					},
+					"url": schema.StringAttribute{
+						Optional: true,
+						MarkdownDescription: "The url to the consent page in the locale." +
+							"\nNote that the attribute `url` is required only if the `consent_type` is set to **URL**.",
+					},
+				},
+			},
+		},
+		"content_hash": schema.StringAttribute{
+			Computed: true,
+			MarkdownDescription: "The SHA-256 hash of `consent_type`, `scopes`, `required_fields` and `locales`." +
+				" A change of the hash creates a new consent version.",
+		},
+		"current_version": schema.Float64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The version number of the current consent version.",
+		},
+		"current_version_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The unique identifier of the current consent version.",
+		},
+		"versions": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "The read-only history of all versions of the consent, ordered by version.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The unique identifier of the consent version.",
+					},
+					"version": schema.Float64Attribute{
+						Computed:            true,
+						MarkdownDescription: "The version number of the consent version.",
+					},
+					"created_at": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The timestamp when the consent version was created.",
+					},
+				},
+			},
+		},
+	},
+}
+
+func (r *ConsentPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ConsentPolicyConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.ConsentType.IsUnknown() {
+		return
+	}
+
+	consentType := config.ConsentType.ValueString()
+	if consentType == SCOPES && config.Scopes.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("scopes"), "Missing required attribute", "attribute 'scopes' is required when consent_type is 'SCOPES'")
+	}
+	if consentType == SCOPES && config.RequiredFields.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("required_fields"), "Missing required attribute", "attribute 'required_fields' is required when consent_type is 'SCOPES'")
+	}
+	if consentType == URL && !config.Scopes.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("scopes"), "Unsupported attribute", "attribute 'scopes' not supported when consent_type is 'URL'")
+	}
+	if consentType == URL && !config.RequiredFields.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("required_fields"), "Unsupported attribute", "attribute 'required_fields' not supported when consent_type is 'URL'")
+	}
+
+	if config.Locales.IsNull() || config.Locales.IsUnknown() {
+		return
+	}
+	locales := map[string]ConsentPolicyLocale{}
+	resp.Diagnostics.Append(config.Locales.ElementsAs(ctx, &locales, false)...)
+	for locale, value := range locales {
+		if consentType == SCOPES && !value.URL.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("locales").AtMapKey(locale).AtName("url"), "Unsupported attribute",
+				"attribute 'url' not supported when consent_type is 'SCOPES'")
+		}
+		if consentType == URL && value.URL.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("locales").AtMapKey(locale).AtName("url"), "Missing required attribute",
+				"attribute 'url' is required when consent_type is 'URL'")
+		}
+	}
+}
+
+// ModifyPlan plans a new consent version whenever the content hash changes.
+// Otherwise the current version and the version history are carried over from the state.
+func (r *ConsentPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ConsentPolicyConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hash, known, diags := plan.contentHash(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !known {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), hash)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var state ConsentPolicyConfig
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || state.ContentHash.ValueString() != hash {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("current_version"), state.CurrentVersion)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("current_version_id"), state.CurrentVersionID)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("versions"), state.Versions)...)
+}
+
+type ConsentPolicyIdentity struct {
+	ConsentGroupID types.String `tfsdk:"consent_group_id"`
+	Name           types.String `tfsdk:"name"`
+	Locales        types.List   `tfsdk:"locales"`
+}
+
+func (c *ConsentPolicyConfig) identity() ConsentPolicyIdentity {
+	locales := make([]string, 0, len(c.Locales.Elements()))
+	for locale := range c.Locales.Elements() {
+		locales = append(locales, locale)
+	}
+	return ConsentPolicyIdentity{
+		ConsentGroupID: c.ConsentGroupID,
+		Name:           c.Name,
+		Locales:        localeList(locales),
+	}
+}
+
+func (i ConsentPolicyIdentity) importID() string {
+	parts := []string{i.ConsentGroupID.ValueString(), i.Name.ValueString()}
+	for _, locale := range i.Locales.Elements() {
+		if l, ok := locale.(types.String); ok {
+			parts = append(parts, l.ValueString())
+		}
+	}
+	return strings.Join(parts, ":")
+}
+
+// localeList returns the sorted locales as the list of an identity.
+func localeList(locales []string) types.List {
+	sort.Strings(locales)
+	values := make([]attr.Value, len(locales))
+	for i, locale := range locales {
+		values[i] = types.StringValue(locale)
+	}
+	return types.ListValueMust(types.StringType, values)
+}
+
+// Metadata marks the identity as mutable as the locales of the identity change with the configuration.
+func (r *ConsentPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	r.BaseResource.Metadata(ctx, req, resp)
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+func (r *ConsentPolicyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"consent_group_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the consent group of the consent.",
+			},
+			"name": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The name of the consent.",
+			},
+			"locales": identityschema.ListAttribute{
+				ElementType:       types.StringType,
+				RequiredForImport: true,
+				Description:       "The locales of the consent policy. They can not be listed in cidaas and are required to read the content of the policy.",
+			},
+		},
+	}
+}
+
+func (r *ConsentPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
+	var plan ConsentPolicyConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get plan data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	res, err := r.cidaasClient.Consent.Upsert(ctx, cidaas.ConsentModel{
+		ConsentName:    plan.Name.ValueString(),
+		ConsentGroupID: plan.ConsentGroupID.ValueString(),
+		Enabled:        plan.Enabled.ValueBool(),
+	})
+	if err != nil {
+		tflog.Error(ctx, "failed to create consent via API", util.H{
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to create consent policy", util.FormatErrorMessage(err))
+		return
+	}
+	plan.ID = util.StringValueOrNull(&res.Data.ID)
+	tflog.Info(ctx, "successfully created consent via API", util.H{
+		"consent_id": plan.ID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(r.createVersion(ctx, &plan, 1)...)
+	if resp.Diagnostics.HasError() {
+		// the consent is not saved in the state without its version, it is deleted to not leave it orphaned in cidaas.
+		// The deletion runs even if the create timed out.
+		if err := r.cidaasClient.Consent.Delete(context.WithoutCancel(ctx), plan.ID.ValueString()); err != nil {
+			tflog.Error(ctx, "failed to delete consent via API after its version could not be created", util.H{
+				"consent_id": plan.ID.ValueString(),
+				"error":      err.Error(),
+			})
+			resp.Diagnostics.AddError(
+				"failed to delete consent policy",
+				fmt.Sprintf("The consent %s was created but its version could not be created. The consent could not be deleted and must be deleted in cidaas: %s",
+					plan.ID.ValueString(), util.FormatErrorMessage(err)),
+			)
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "resource consent_policy created successfully", util.H{
+		"consent_id": plan.ID.ValueString(),
+	})
+}
+
+func (r *ConsentPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
+	var state ConsentPolicyConfig
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get state data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	res, err := r.cidaasClient.Consent.GetConsentInstances(ctx, state.ConsentGroupID.ValueString())
+	if err != nil {
+		tflog.Error(ctx, "failed to read consent instances via API", util.H{
+			"consent_group_id": state.ConsentGroupID.ValueString(),
+			"error":            err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to read consent policy", util.FormatErrorMessage(err))
+		return
+	}
+
+	var consent *cidaas.ConsentModel
+	for i, instance := range res.Data {
+		if strings.EqualFold(instance.ConsentName, state.Name.ValueString()) {
+			consent = &res.Data[i]
+			break
+		}
+	}
+	if consent == nil {
+		tflog.Warn(ctx, "consent no longer exists, removing resource from state", util.H{
+			"consent_group_id": state.ConsentGroupID.ValueString(),
+			"name":             state.Name.ValueString(),
+		})
+		// terraform requires the identity of a resource state which did not have one yet even if it is removed
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.ID = types.StringValue(consent.ID)
+	state.Enabled = types.BoolValue(consent.Enabled)
+
+	resp.Diagnostics.Append(r.readVersions(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Debug(ctx, "resource consent_policy read successfully", util.H{
+		"consent_id":         state.ID.ValueString(),
+		"current_version_id": state.CurrentVersionID.ValueString(),
+	})
+}
+
+func (r *ConsentPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
+	var plan, state ConsentPolicyConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get plan or state data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	if !plan.Enabled.Equal(state.Enabled) {
+		_, err := r.cidaasClient.Consent.Upsert(ctx, cidaas.ConsentModel{
+			ID:             state.ID.ValueString(),
+			ConsentName:    plan.Name.ValueString(),
+			ConsentGroupID: plan.ConsentGroupID.ValueString(),
+			Enabled:        plan.Enabled.ValueBool(),
+		})
+		if err != nil {
+			tflog.Error(ctx, "failed to update consent via API", util.H{
+				"consent_id": state.ID.ValueString(),
+				"error":      err.Error(),
+			})
+			resp.Diagnostics.AddError("failed to update consent policy", util.FormatErrorMessage(err))
+			return
+		}
+	}
+
+	if !plan.ContentHash.Equal(state.ContentHash) {
+		resp.Diagnostics.Append(r.createVersion(ctx, &plan, state.CurrentVersion.ValueFloat64()+1)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state after update", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Debug(ctx, "resource consent_policy updated successfully", util.H{
+		"consent_id":         plan.ID.ValueString(),
+		"current_version_id": plan.CurrentVersionID.ValueString(),
+	})
+}
+
+func (r *ConsentPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
+	var state ConsentPolicyConfig
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get state data for deletion", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	if err := r.cidaasClient.Consent.Delete(ctx, state.ID.ValueString()); err != nil {
+		tflog.Error(ctx, "failed to delete consent via API", util.H{
+			"consent_id": state.ID.ValueString(),
+			"error":      err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to delete consent policy", util.FormatErrorMessage(err))
+		return
+	}
+
+	tflog.Info(ctx, "resource consent_policy deleted successfully", util.H{
+		"consent_id": state.ID.ValueString(),
+	})
+}
+
+// ImportState expects the format consent_group_id:name:locale[:locale...] as the locales of a consent version cannot be listed.
+func (r *ConsentPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := importID[ConsentPolicyIdentity](ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	parts := strings.Split(id, ":")
+	if len(parts) < 3 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: 'consent_group_id:name:locale1:locale2', got: %s", id),
+		)
+		return
+	}
+
+	locales := map[string]attr.Value{}
+	for _, locale := range parts[2:] {
+		locales[locale] = types.ObjectNull(consentPolicyLocaleType.AttrTypes)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("consent_group_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("locales"), types.MapValueMust(consentPolicyLocaleType, locales))...)
+}
+
+// createVersion creates a consent version with all locales of the plan and refreshes the version attributes of the plan.
+// The version is deleted again if one of its locales cannot be created.
+func (r *ConsentPolicyResource) createVersion(ctx context.Context, plan *ConsentPolicyConfig, version float64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	locales := map[string]ConsentPolicyLocale{}
+	diags.Append(plan.Locales.ElementsAs(ctx, &locales, false)...)
+	consentVersion := cidaas.ConsentVersionModel{
+		Version:     version,
+		ConsentID:   plan.ID.ValueString(),
+		ConsentType: plan.ConsentType.ValueString(),
+	}
+	if consentVersion.ConsentType == SCOPES {
+		diags.Append(plan.Scopes.ElementsAs(ctx, &consentVersion.Scopes, false)...)
+		diags.Append(plan.RequiredFields.ElementsAs(ctx, &consentVersion.RequiredFields, false)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	// the API requires one locale in the payload of a new version, the remaining locales are added with the locale API
+	localeKeys := make([]string, 0, len(locales))
+	for locale := range locales {
+		localeKeys = append(localeKeys, locale)
+	}
+	sort.Strings(localeKeys)
+	consentVersion.ConsentLocale = cidaas.ConsentLocale{
+		Locale:  localeKeys[0],
+		Content: locales[localeKeys[0]].Content.ValueString(),
+		URL:     locales[localeKeys[0]].URL.ValueString(),
+	}
+
+	res, err := r.cidaasClient.ConsentVersion.Upsert(ctx, consentVersion)
+	if err != nil {
+		tflog.Error(ctx, "failed to create consent version via API", util.H{
+			"consent_id": consentVersion.ConsentID,
+			"version":    version,
+			"error":      err.Error(),
+		})
+		diags.AddError("failed to create consent version", util.FormatErrorMessage(err))
+		return diags
+	}
+	versionID := res.Data.ID
+	tflog.Info(ctx, "successfully created consent version via API", util.H{
+		"consent_version_id": versionID,
+		"version":            version,
+	})
+
+	for _, locale := range localeKeys[1:] {
+		_, err := r.cidaasClient.ConsentVersion.UpsertLocal(ctx, cidaas.ConsentLocalModel{
+			ConsentID:        consentVersion.ConsentID,
+			ConsentVersionID: versionID,
+			Locale:           locale,
+			Content:          locales[locale].Content.ValueString(),
+			URL:              locales[locale].URL.ValueString(),
+		})
+		if err != nil {
+			tflog.Error(ctx, "failed to create consent locale via API", util.H{
+				"consent_version_id": versionID,
+				"locale":             locale,
+				"error":              err.Error(),
+			})
+			diags.AddError("failed to create consent locale", util.FormatErrorMessage(err))
+			// the version is published without all its locales and not saved in the state, it is deleted so that
+			// the next apply does not leave it behind. The deletion runs even if the operation timed out.
+			if err := r.cidaasClient.ConsentVersion.Delete(context.WithoutCancel(ctx), versionID); err != nil {
+				tflog.Error(ctx, "failed to delete consent version via API after its locales could not be created", util.H{
+					"consent_version_id": versionID,
+					"error":              err.Error(),
+				})
+				diags.AddError(
+					"failed to delete consent version",
+					fmt.Sprintf("The consent version %s was created without all its locales and could not be deleted, it must be deleted in cidaas: %s",
+						versionID, util.FormatErrorMessage(err)),
+				)
+			}
+			return diags
+		}
+	}
+
+	versions, err := r.cidaasClient.ConsentVersion.Get(ctx, consentVersion.ConsentID)
+	if err != nil {
+		tflog.Error(ctx, "failed to read consent versions via API", util.H{
+			"consent_id": consentVersion.ConsentID,
+			"error":      err.Error(),
+		})
+		diags.AddError("failed to read consent versions", util.FormatErrorMessage(err))
+		return diags
+	}
+	plan.CurrentVersion = types.Float64Value(version)
+	plan.CurrentVersionID = types.StringValue(versionID)
+	plan.Versions, diags = flattenConsentPolicyVersions(ctx, versions.Data)
+	return diags
+}
+
+// readVersions refreshes the version history and the attributes of the current version from the API.
+// Locales that no longer exist in the current version are removed from the state.
+func (r *ConsentPolicyResource) readVersions(ctx context.Context, state *ConsentPolicyConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	res, err := r.cidaasClient.ConsentVersion.Get(ctx, state.ID.ValueString())
+	if err != nil {
+		tflog.Error(ctx, "failed to read consent versions via API", util.H{
+			"consent_id": state.ID.ValueString(),
+			"error":      err.Error(),
+		})
+		diags.AddError("failed to read consent versions", util.FormatErrorMessage(err))
+		return diags
+	}
+	state.Versions, diags = flattenConsentPolicyVersions(ctx, res.Data)
+	if diags.HasError() {
+		return diags
+	}
+
+	// without a version the content hash is unset, so the next apply creates the first version
+	if len(res.Data) == 0 {
+		state.CurrentVersion = types.Float64Null()
+		state.CurrentVersionID = types.StringNull()
+		state.ContentHash = types.StringNull()
+		return diags
+	}
+
+	current := res.Data[0]
+	for _, version := range res.Data[1:] {
+		if version.Version > current.Version {
+			current = version
+		}
+	}
+	state.CurrentVersion = types.Float64Value(current.Version)
+	state.CurrentVersionID = types.StringValue(current.ID)
+	state.ConsentType = types.StringValue(current.ConsentType)
+
+	// only the keys are used, the values are null after an import
+	refreshed := map[string]attr.Value{}
+	for locale := range state.Locales.Elements() {
+		localeRes, err := r.cidaasClient.ConsentVersion.GetLocal(ctx, current.ID, locale)
+		if err != nil {
+			tflog.Error(ctx, "failed to read consent locale via API", util.H{
+				"consent_version_id": current.ID,
+				"locale":             locale,
+				"error":              err.Error(),
+			})
+			diags.AddError("failed to read consent locale", util.FormatErrorMessage(err))
+			return diags
+		}
+		if !localeRes.Success && localeRes.Status == http.StatusNoContent {
+			tflog.Warn(ctx, "consent locale not found in the current version, removing it from state", util.H{
+				"consent_version_id": current.ID,
+				"locale":             locale,
+			})
+			continue
+		}
+		if current.ConsentType == SCOPES {
+			state.Scopes = util.SetValueOrNull(localeRes.Data.Scopes)
+			state.RequiredFields = util.SetValueOrNull(localeRes.Data.RequiredFields)
+		}
+		refreshed[locale] = types.ObjectValueMust(consentPolicyLocaleType.AttrTypes, map[string]attr.Value{
+			"content": util.StringValueOrNull(&localeRes.Data.Content),
+			"url":     util.StringValueOrNull(&localeRes.Data.URL),
+		})
+	}
+	state.Locales = types.MapValueMust(consentPolicyLocaleType, refreshed)
+
+	hash, _, hashDiags := state.contentHash(ctx)
+	diags.Append(hashDiags...)
+	state.ContentHash = types.StringValue(hash)
+	return diags
+}
+
+func flattenConsentPolicyVersions(ctx context.Context, versions []cidaas.ConsentVersionModel) (types.List, diag.Diagnostics) {
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Version < versions[j].Version })
+	values := make([]attr.Value, 0, len(versions))
+	for _, version := range versions {
+		values = append(values, types.ObjectValueMust(consentPolicyVersionType.AttrTypes, map[string]attr.Value{
+			"id":         types.StringValue(version.ID),
+			"version":    types.Float64Value(version.Version),
+			"created_at": util.StringValueOrNull(&version.CreatedAt),
+		}))
+	}
+	return types.ListValueFrom(ctx, consentPolicyVersionType, values)
+}
+
+// consentPolicyContent is the normalized content of a consent version the content hash is calculated from.
+type consentPolicyContent struct {
+	ConsentType    string                       `json:"consent_type"`
+	Scopes         []string                     `json:"scopes"`
+	RequiredFields []string                     `json:"required_fields"`
+	Locales        map[string]map[string]string `json:"locales"`
+}
+
+// contentHash returns the SHA-256 hash of the versioned attributes and whether all of them are known.
+func (c *ConsentPolicyConfig) contentHash(ctx context.Context) (string, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if c.ConsentType.IsUnknown() || c.Scopes.IsUnknown() || c.RequiredFields.IsUnknown() || c.Locales.IsUnknown() {
+		return "", false, diags
+	}
+
+	content := consentPolicyContent{
+		ConsentType:    c.ConsentType.ValueString(),
+		Scopes:         []string{},
+		RequiredFields: []string{},
+		Locales:        map[string]map[string]string{},
+	}
+	diags.Append(c.Scopes.ElementsAs(ctx, &content.Scopes, false)...)
+	diags.Append(c.RequiredFields.ElementsAs(ctx, &content.RequiredFields, false)...)
+	locales := map[string]ConsentPolicyLocale{}
+	diags.Append(c.Locales.ElementsAs(ctx, &locales, false)...)
+	if diags.HasError() {
+		return "", false, diags
+	}
+	for locale, value := range locales {
+		if value.Content.IsUnknown() || value.URL.IsUnknown() {
+			return "", false, diags
+		}
+		content.Locales[locale] = map[string]string{
+			"content": value.Content.ValueString(),
+			"url":     value.URL.ValueString(),
+		}
+	}
+	// a null and an empty set result in the same hash
+	if content.Scopes == nil {
+		content.Scopes = []string{}
+	}
+	if content.RequiredFields == nil {
+		content.RequiredFields = []string{}
+	}
+	sort.Strings(content.Scopes)
+	sort.Strings(content.RequiredFields)
+
+	// maps are encoded with sorted keys, which makes the encoding deterministic
+	data, err := json.Marshal(content)
+	if err != nil {
+		diags.AddError("failed to calculate content hash", err.Error())
+		return "", false, diags
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), true, diags
+}
@@ -0,0 +1,310 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	acctest "github.com/Cidaas/terraform-provider-cidaas/internal/test"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestConsentPolicy_Basic(t *testing.T) {
+	t.Parallel()
+
+	testResourceID := acctest.RandString(10)
+	testResourceName := fmt.Sprintf("%s.%s", resources.RESOURCE_CONSENT_POLICY, testResourceID)
+	consentName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckConsentPolicyDestroyed(testResourceName),
+		Steps: []resource.TestStep{
+			{
+				Config: testConsentPolicyConfig(testResourceID, consentName, true, "consent in German"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "content_hash"),
+					resource.TestCheckResourceAttrSet(testResourceName, "current_version_id"),
+					resource.TestCheckResourceAttr(testResourceName, "current_version", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "versions.#", "1"),
+				),
+			},
+			// disabling the consent does not create a new version
+			{
+				Config: testConsentPolicyConfig(testResourceID, consentName, false, "consent in German"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "current_version", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "versions.#", "1"),
+				),
+			},
+			// changing the content creates a new version and keeps the previous one
+			{
+				Config: testConsentPolicyConfig(testResourceID, consentName, false, "updated consent in German"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "current_version", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "versions.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "versions.0.version", "1"),
+					resource.TestCheckResourceAttrPair(testResourceName, "versions.1.id", testResourceName, "current_version_id"),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[testResourceName]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", testResourceName)
+					}
+					return rs.Primary.Attributes["consent_group_id"] + ":" + consentName + ":de:en", nil
+				},
+			},
+		},
+	})
+}
+
+func TestConsentPolicy_MissingURL(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					provider "cidaas" {
+						base_url = "%s"
+					}
+					resource "cidaas_consent_policy" "example" {
+						consent_group_id = "sample"
+						name             = "sample"
+						consent_type     = "URL"
+						locales = {
+							en = {
+								content = "consent in English"
+							}
+						}
+					}
+				`, acctest.GetBaseURL()),
+				ExpectError: regexp.MustCompile("Missing required attribute"),
+			},
+		},
+	})
+}
+
+// the consent is deleted if its version cannot be created, it is not left orphaned in cidaas
+func TestConsentPolicy_CreateVersionFailure(t *testing.T) {
+	ctx := context.Background()
+	var deleted atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/consent/instance"):
+			_, _ = w.Write([]byte(`{"success":true,"data":{"_id":"consent-id","consent_name":"sample"}}`))
+		case r.Method == http.MethodDelete && strings.HasSuffix(r.URL.Path, "/consent/instance/consent-id"):
+			deleted.Store(true)
+			_, _ = w.Write([]byte(`{"success":true}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"success":false,"status":500}`))
+		}
+	}))
+	defer server.Close()
+
+	r := resources.NewConsentPolicyResource()
+	config := cidaas.ClientConfig{BaseURL: server.URL, AccessToken: "test-token"}
+	client := &cidaas.Client{Consent: cidaas.NewConsent(config), ConsentVersion: cidaas.NewConsentVersion(config)}
+	configureResp := fwresource.ConfigureResponse{}
+	r.(fwresource.ResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{ProviderData: client}, &configureResp)
+
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	localeType := types.ObjectType{AttrTypes: map[string]attr.Type{"content": types.StringType, "url": types.StringType}}
+	diags := plan.Set(ctx, resources.ConsentPolicyConfig{
+		ID:             types.StringUnknown(),
+		ConsentGroupID: types.StringValue("group"),
+		Name:           types.StringValue("sample"),
+		Enabled:        types.BoolValue(true),
+		ConsentType:    types.StringValue("URL"),
+		Scopes:         types.SetNull(types.StringType),
+		RequiredFields: types.SetNull(types.StringType),
+		Locales: types.MapValueMust(localeType, map[string]attr.Value{
+			"en": types.ObjectValueMust(localeType.AttrTypes, map[string]attr.Value{
+				"content": types.StringValue("consent in English"),
+				"url":     types.StringValue("https://example.com/consent"),
+			}),
+		}),
+		ContentHash:      types.StringUnknown(),
+		CurrentVersion:   types.Float64Unknown(),
+		CurrentVersionID: types.StringUnknown(),
+		Versions:         types.ListUnknown(types.ObjectType{AttrTypes: map[string]attr.Type{"id": types.StringType, "version": types.Float64Type, "created_at": types.StringType}}),
+		Timeouts:         nullTimeouts(),
+	})
+	if diags.HasError() {
+		t.Fatalf("Failed to set plan: %v", diags)
+	}
+
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw.Copy()}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &resp)
+	if errs := resp.Diagnostics.Errors(); len(errs) != 1 || errs[0].Summary() != "failed to create consent version" {
+		t.Errorf("Expected the error of the consent version, got %v", resp.Diagnostics)
+	}
+	if !deleted.Load() {
+		t.Error("Expected the consent to be deleted")
+	}
+}
+
+// the version created during an update is deleted again if one of its locales cannot be created
+func TestConsentPolicy_UpdateLocaleFailure(t *testing.T) {
+	ctx := context.Background()
+	var deleted atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/consent/versions"):
+			_, _ = w.Write([]byte(`{"success":true,"data":{"_id":"version-id","consent_id":"consent-id","version":2}}`))
+		case r.Method == http.MethodDelete && strings.HasSuffix(r.URL.Path, "/consent/versions/version-id"):
+			deleted.Store(true)
+			_, _ = w.Write([]byte(`{"success":true}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"success":false,"status":500}`))
+		}
+	}))
+	defer server.Close()
+
+	r := resources.NewConsentPolicyResource()
+	config := cidaas.ClientConfig{BaseURL: server.URL, AccessToken: "test-token"}
+	client := &cidaas.Client{Consent: cidaas.NewConsent(config), ConsentVersion: cidaas.NewConsentVersion(config)}
+	configureResp := fwresource.ConfigureResponse{}
+	r.(fwresource.ResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{ProviderData: client}, &configureResp)
+
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	localeType := types.ObjectType{AttrTypes: map[string]attr.Type{"content": types.StringType, "url": types.StringType}}
+	versionType := types.ObjectType{AttrTypes: map[string]attr.Type{"id": types.StringType, "version": types.Float64Type, "created_at": types.StringType}}
+	consentPolicy := func(content, contentHash string) resources.ConsentPolicyConfig {
+		return resources.ConsentPolicyConfig{
+			ID:             types.StringValue("consent-id"),
+			ConsentGroupID: types.StringValue("group"),
+			Name:           types.StringValue("sample"),
+			Enabled:        types.BoolValue(true),
+			ConsentType:    types.StringValue("URL"),
+			Scopes:         types.SetNull(types.StringType),
+			RequiredFields: types.SetNull(types.StringType),
+			Locales: types.MapValueMust(localeType, map[string]attr.Value{
+				"de": types.ObjectValueMust(localeType.AttrTypes, map[string]attr.Value{
+					"content": types.StringValue(content),
+					"url":     types.StringValue("https://example.com/consent/de"),
+				}),
+				"en": types.ObjectValueMust(localeType.AttrTypes, map[string]attr.Value{
+					"content": types.StringValue("consent in English"),
+					"url":     types.StringValue("https://example.com/consent/en"),
+				}),
+			}),
+			ContentHash:      types.StringValue(contentHash),
+			CurrentVersion:   types.Float64Value(1),
+			CurrentVersionID: types.StringValue("first-version-id"),
+			Versions:         types.ListValueMust(versionType, []attr.Value{}),
+			Timeouts:         nullTimeouts(),
+		}
+	}
+
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := plan.Set(ctx, consentPolicy("Einwilligung aktualisiert", "new-hash"))
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags.Append(state.Set(ctx, consentPolicy("Einwilligung", "old-hash"))...)
+	if diags.HasError() {
+		t.Fatalf("Failed to set plan and state: %v", diags)
+	}
+
+	resp := fwresource.UpdateResponse{State: tfsdk.State{Schema: state.Schema, Raw: state.Raw.Copy()}}
+	r.Update(ctx, fwresource.UpdateRequest{Plan: plan, State: state}, &resp)
+	if errs := resp.Diagnostics.Errors(); len(errs) != 1 || errs[0].Summary() != "failed to create consent locale" {
+		t.Errorf("Expected the error of the consent locale, got %v", resp.Diagnostics)
+	}
+	if !deleted.Load() {
+		t.Error("Expected the partially created consent version to be deleted")
+	}
+}
+
+func testConsentPolicyConfig(resourceID, consentName string, enabled bool, content string) string {
+	return fmt.Sprintf(`
+		provider "cidaas" {
+			base_url = "%s"
+		}
+		resource "cidaas_consent_group" "sample" {
+			group_name  = "%s"
+			description = "sample description"
+		}
+		resource "cidaas_consent_policy" "%s" {
+			consent_group_id = cidaas_consent_group.sample.id
+			name             = "%s"
+			enabled          = %t
+			consent_type     = "SCOPES"
+			scopes           = ["developer"]
+			required_fields  = ["name"]
+			locales = {
+				de = {
+					content = "%s"
+				}
+				en = {
+					content = "consent in English"
+				}
+			}
+		}
+	`, acctest.GetBaseURL(), consentName, resourceID, consentName, enabled, content)
+}
+
+func testCheckConsentPolicyDestroyed(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+
+		consent := cidaas.Consent{
+			ClientConfig: cidaas.ClientConfig{
+				BaseURL:     acctest.GetBaseURL(),
+				AccessToken: acctest.TestToken,
+			},
+		}
+		// the consent group is destroyed as well, a failing lookup means the consent is gone
+		res, err := consent.GetConsentInstances(context.Background(), rs.Primary.Attributes["consent_group_id"])
+		if err != nil {
+			return nil
+		}
+		for _, instance := range res.Data {
+			if instance.ID == rs.Primary.ID {
+				return fmt.Errorf("consent %s still exists", rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
@@ -9,11 +9,14 @@ import (
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
 	"github.com/Cidaas/terraform-provider-cidaas/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -52,6 +55,7 @@ type ConsentVersionConfig struct {
 	ConsentLocales types.Set     `tfsdk:"consent_locales"`
 
 	consentLocale []*ConsentLocale
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 type ConsentLocale struct {
@@ -99,6 +103,10 @@ func (r *ConsentVersionResource) ValidateConfig(ctx context.Context, req resourc
 var consentversionSchema = schema.Schema{
 	MarkdownDescription: "The Consent Version resource in the provider allows you to manage different versions of a specific consent in Cidaas." +
 		"\n This resource also supports managing consent versions across multiple locales enabling different configurations such as URLs and content for each locale." +
+		" Locales removed from `consent_locales` are deleted in cidaas." +
+		"\n\n A new version must be greater than the latest existing version of the consent, this is validated during plan." +
+		" Destroying the resource deletes the consent version including all its locales." +
+		" To keep the history of a consent, add a new `cidaas_consent_version` with a higher version instead of destroying the old one." +
 		"\n\n Ensure that the below scopes are assigned to the client with the specified `client_id`:" +
 		"\n- cidaas:tenant_consent_read" +
 		"\n- cidaas:tenant_consent_write" +
@@ -181,7 +189,11 @@ var consentversionSchema = schema.Schema{
 					},
 				},
 			},
-			Required: true,
+			Required:            true,
+			MarkdownDescription: "The locales of the consent version. Locales removed from the set are deleted in cidaas.",
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+			},
 		},
 	},
 }
@@ -195,7 +207,108 @@ func (cv *ConsentVersionConfig) extract(ctx context.Context) diag.Diagnostics {
 	return diags
 }
 
+func (r *ConsentVersionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// the versioning rules only apply when a new version is created
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() || r.cidaasClient == nil {
+		return
+	}
+
+	var plan ConsentVersionConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ConsentID.IsUnknown() || plan.Version.IsUnknown() {
+		return
+	}
+
+	res, err := r.cidaasClient.ConsentVersion.Get(ctx, plan.ConsentID.ValueString())
+	if err != nil {
+		tflog.Error(ctx, "failed to read consent versions via API", util.H{
+			"consent_id": plan.ConsentID.ValueString(),
+			"error":      err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to read consent versions", util.FormatErrorMessage(err))
+		return
+	}
+
+	latestVersion := 0.0
+	versions := make([]float64, 0, len(res.Data))
+	for _, version := range res.Data {
+		versions = append(versions, version.Version)
+		if version.Version > latestVersion {
+			latestVersion = version.Version
+		}
+	}
+	if len(versions) > 0 && plan.Version.ValueFloat64() <= latestVersion {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("version"),
+			"Invalid Consent Version",
+			fmt.Sprintf("The version of a new consent version must be greater than the latest version %v of the consent_id %s, got: %v. Existing versions %v",
+				latestVersion, plan.ConsentID.ValueString(), plan.Version.ValueFloat64(), versions),
+		)
+	}
+}
+
+type ConsentVersionIdentity struct {
+	ConsentID types.String `tfsdk:"consent_id"`
+	ID        types.String `tfsdk:"id"`
+	Locales   types.List   `tfsdk:"locales"`
+}
+
+func (c *ConsentVersionConfig) identity() ConsentVersionIdentity {
+	var locales []string
+	for _, value := range c.ConsentLocales.Elements() {
+		if obj, ok := value.(types.Object); ok {
+			if locale, ok := obj.Attributes()["locale"].(types.String); ok {
+				locales = append(locales, locale.ValueString())
+			}
+		}
+	}
+	return ConsentVersionIdentity{
+		ConsentID: c.ConsentID,
+		ID:        c.ID,
+		Locales:   localeList(locales),
+	}
+}
+
+func (i ConsentVersionIdentity) importID() string {
+	parts := []string{i.ConsentID.ValueString(), i.ID.ValueString()}
+	for _, locale := range i.Locales.Elements() {
+		if l, ok := locale.(types.String); ok {
+			parts = append(parts, l.ValueString())
+		}
+	}
+	return strings.Join(parts, ":")
+}
+
+// Metadata marks the identity as mutable as the locales of the identity change with the configuration.
+func (r *ConsentVersionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	r.BaseResource.Metadata(ctx, req, resp)
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+func (r *ConsentVersionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"consent_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the consent of the version.",
+			},
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique identifier of the consent version.",
+			},
+			"locales": identityschema.ListAttribute{
+				ElementType:       types.StringType,
+				RequiredForImport: true,
+				Description:       "The locales of the consent version. They can not be listed in cidaas and are required to read the content of the version.",
+			},
+		},
+	}
+}
+
 func (r *ConsentVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
 	var plan ConsentVersionConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(plan.extract(ctx)...)
@@ -276,6 +389,7 @@ func (r *ConsentVersionResource) Create(ctx context.Context, req resource.Create
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -287,6 +401,9 @@ func (r *ConsentVersionResource) Create(ctx context.Context, req resource.Create
 }
 
 func (r *ConsentVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
 	var state ConsentVersionConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	resp.Diagnostics.Append(state.extract(ctx)...)
@@ -408,6 +525,7 @@ func (r *ConsentVersionResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -419,11 +537,15 @@ func (r *ConsentVersionResource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 func (r *ConsentVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
 	var plan, state ConsentVersionConfig
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(plan.extract(ctx)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(state.extract(ctx)...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to get plan/state data or extract configurations", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -453,7 +575,32 @@ func (r *ConsentVersionResource) Update(ctx context.Context, req resource.Update
 		}
 	}
 
+	planLocales := make([]string, 0, len(plan.consentLocale))
+	for _, pcl := range plan.consentLocale {
+		planLocales = append(planLocales, pcl.Locale.ValueString())
+	}
+	for _, scl := range state.consentLocale {
+		if util.Contains(planLocales, scl.Locale.ValueString()) {
+			continue
+		}
+		err := r.cidaasClient.ConsentVersion.DeleteLocal(ctx, state.ID.ValueString(), scl.Locale.ValueString())
+		if err != nil {
+			tflog.Error(ctx, "failed to delete consent locale via API", util.H{
+				"locale":             scl.Locale.ValueString(),
+				"consent_version_id": state.ID.ValueString(),
+				"error":              err.Error(),
+			})
+			resp.Diagnostics.AddError("Failed to delete consent locale", fmt.Sprintf("Error: %s", err.Error()))
+			return
+		}
+		tflog.Info(ctx, "deleted consent locale removed from the configuration", util.H{
+			"locale":             scl.Locale.ValueString(),
+			"consent_version_id": state.ID.ValueString(),
+		})
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state after update", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -464,11 +611,39 @@ func (r *ConsentVersionResource) Update(ctx context.Context, req resource.Update
 	tflog.Info(ctx, "successfully completed consent version update")
 }
 
-func (r *ConsentVersionResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+func (r *ConsentVersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
+	var state ConsentVersionConfig
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get state data for deletion", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	err := r.cidaasClient.ConsentVersion.Delete(ctx, state.ID.ValueString())
+	if err != nil {
+		tflog.Error(ctx, "failed to delete consent version via API", util.H{
+			"consent_version_id": state.ID.ValueString(),
+			"error":              err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to delete consent version", util.FormatErrorMessage(err))
+		return
+	}
+
+	tflog.Info(ctx, "resource consent version deleted successfully", util.H{
+		"consent_version_id": state.ID.ValueString(),
+	})
 }
 
 func (r *ConsentVersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id := req.ID
+	id := importID[ConsentVersionIdentity](ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	parts := strings.Split(id, ":")
 	if len(parts) < 3 {
 		resp.Diagnostics.AddError(
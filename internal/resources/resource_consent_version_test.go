@@ -2,6 +2,7 @@ package resources_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
@@ -82,3 +83,107 @@ func testConsentVersionConfig(content, resourceID string) string {
 		}		
 	`, acctest.GetBaseURL(), resourceID, content)
 }
+
+// locales removed from consent_locales are deleted
+func TestConsentVersion_RemoveLocale(t *testing.T) {
+	t.Parallel()
+
+	testResourceID := acctest.RandString(10)
+	testResourceName := fmt.Sprintf("%s.%s", resources.RESOURCE_CONSENT_VERSION, testResourceID)
+	consentName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testConsentVersionLocalesConfig(testResourceID, consentName, 1, `
+					{
+						content = "consent version in German"
+						locale  = "de"
+					},
+					{
+						content = "consent version in English"
+						locale  = "en"
+					}
+				`),
+				Check: resource.TestCheckResourceAttr(testResourceName, "consent_locales.#", "2"),
+			},
+			{
+				Config: testConsentVersionLocalesConfig(testResourceID, consentName, 1, `
+					{
+						content = "consent version in German"
+						locale  = "de"
+					}
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "consent_locales.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "consent_locales.0.locale", "de"),
+				),
+			},
+		},
+	})
+}
+
+// a new version must be greater than the latest version of the consent
+func TestConsentVersion_VersionNotIncremented(t *testing.T) {
+	t.Parallel()
+
+	testResourceID := acctest.RandString(10)
+	consentName := acctest.RandString(10)
+	locales := `
+		{
+			content = "consent version in English"
+			locale  = "en"
+		}
+	`
+	config := testConsentVersionLocalesConfig(testResourceID, consentName, 2, locales)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				Config: config + fmt.Sprintf(`
+					resource "cidaas_consent_version" "%s_old" {
+						version         = 1
+						consent_id      = cidaas_consent.%s.id
+						consent_type    = "SCOPES"
+						scopes          = ["developer"]
+						required_fields = ["name"]
+						consent_locales = [%s]
+					}
+				`, testResourceID, testResourceID, locales),
+				ExpectError: regexp.MustCompile("Invalid Consent Version"),
+			},
+		},
+	})
+}
+
+func testConsentVersionLocalesConfig(resourceID, consentName string, version int, locales string) string {
+	return fmt.Sprintf(`
+		provider "cidaas" {
+			base_url = "%s"
+		}
+		resource "cidaas_consent_group" "%s" {
+			group_name  = "%s"
+			description = "sample description"
+		}
+		resource "cidaas_consent" "%s" {
+			consent_group_id = cidaas_consent_group.%s.id
+			name             = "%s"
+			enabled          = true
+		}
+		resource "cidaas_consent_version" "%s" {
+			version         = %d
+			consent_id      = cidaas_consent.%s.id
+			consent_type    = "SCOPES"
+			scopes          = ["developer"]
+			required_fields = ["name"]
+			consent_locales = [%s]
+		}
+	`, acctest.GetBaseURL(), resourceID, consentName, resourceID, resourceID, consentName, resourceID, version, resourceID, locales)
+}
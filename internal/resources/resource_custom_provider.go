@@ -8,11 +8,13 @@ import (
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
 	"github.com/Cidaas/terraform-provider-cidaas/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
@@ -66,6 +68,7 @@ type ProviderConfig struct {
 	apiKeyDetails         *AuthConfig
 	totpDetails           *AuthConfig
 	cidaasAuthDetails     *CidaasAuthConfig
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
 }
 
 type CpScope struct {
@@ -448,7 +451,25 @@ var customProviderSchema = schema.Schema{
 	},
 }
 
+type CustomProviderIdentity struct {
+	ProviderName types.String `tfsdk:"provider_name"`
+}
+
+func (r *CustomProvider) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"provider_name": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique identifier of the custom provider.",
+			},
+		},
+	}
+}
+
 func (r *CustomProvider) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
 	var plan ProviderConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(plan.extract(ctx)...)
@@ -474,6 +495,7 @@ func (r *CustomProvider) Create(ctx context.Context, req resource.CreateRequest,
 
 	plan.ID = util.StringValueOrNull(&res.Data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, CustomProviderIdentity{ProviderName: plan.ProviderName})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "Failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -485,6 +507,9 @@ func (r *CustomProvider) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *CustomProvider) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
 	var state ProviderConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -715,9 +740,13 @@ func (r *CustomProvider) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, CustomProviderIdentity{ProviderName: state.ProviderName})...)
 }
 
 func (r *CustomProvider) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
 	var plan, state ProviderConfig
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -754,6 +783,7 @@ func (r *CustomProvider) Update(ctx context.Context, req resource.UpdateRequest,
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, CustomProviderIdentity{ProviderName: plan.ProviderName})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "Failed to set state after update", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -765,6 +795,9 @@ func (r *CustomProvider) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *CustomProvider) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
 	var state ProviderConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -789,7 +822,7 @@ func (r *CustomProvider) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *CustomProvider) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("provider_name"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("provider_name"), path.Root("provider_name"), req, resp)
 }
 
 func prepareCpRequestPayload(ctx context.Context, plan ProviderConfig) (*cidaas.CustomProviderModel, diag.Diagnostics) {
@@ -3,7 +3,6 @@ package resources_test
 import (
 	"context"
 	"fmt"
-	"os"
 	"regexp"
 	"strconv"
 	"strings"
@@ -170,7 +169,7 @@ func checkCustomProviderDestroyed(resourceName string) resource.TestCheckFunc {
 
 		cp := cidaas.CustomProvider{
 			ClientConfig: cidaas.ClientConfig{
-				BaseURL:     os.Getenv("BASE_URL"),
+				BaseURL:     acctest.GetBaseURL(),
 				AccessToken: acctest.TestToken,
 			},
 		}
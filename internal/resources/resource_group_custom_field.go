@@ -0,0 +1,326 @@
+package resources
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type GroupCustomFieldResource struct {
+	BaseResource
+}
+
+func NewGroupCustomFieldResource() resource.Resource {
+	return &GroupCustomFieldResource{
+		BaseResource: NewBaseResource(
+			BaseResourceConfig{
+				Name:   RESOURCE_GROUP_CUSTOM_FIELD,
+				Schema: &groupCustomFieldSchema,
+			},
+		),
+	}
+}
+
+type GroupCustomFieldConfig struct {
+	ID         types.String   `tfsdk:"id"`
+	FieldKey   types.String   `tfsdk:"field_key"`
+	DataType   types.String   `tfsdk:"data_type"`
+	Required   types.Bool     `tfsdk:"required"`
+	GroupTypes types.Set      `tfsdk:"group_types"`
+	CreatedAt  types.String   `tfsdk:"created_at"`
+	UpdatedAt  types.String   `tfsdk:"updated_at"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+var groupCustomFieldSchema = schema.Schema{
+	MarkdownDescription: "The `cidaas_group_custom_field` resource defines a custom field that can be set on user groups through the" +
+		" `custom_fields` attribute of the `cidaas_user_group` resource. Once at least one field is defined," +
+		" the keys and values of `custom_fields` are validated against the field definitions during plan." +
+		"\n\n Ensure that the below scopes are assigned to the client with the specified `client_id`:" +
+		"\n- cidaas:groups_write" +
+		"\n- cidaas:groups_read" +
+		"\n- cidaas:groups_delete",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the group custom field resource.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"field_key": schema.StringAttribute{
+			Required: true,
+			MarkdownDescription: "The key of the custom field as used in the `custom_fields` map of a user group." +
+				" It cannot be updated for an existing state.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.RegexMatches(
+					regexp.MustCompile(`^[a-zA-Z0-9_]+$`),
+					"must contain only alphanumeric characters and underscores",
+				),
+			},
+			PlanModifiers: []planmodifier.String{
+				&validators.UniqueIdentifier{},
+			},
+		},
+		"data_type": schema.StringAttribute{
+			Required: true,
+			MarkdownDescription: "The data type of the custom field value. Allowed values are `TEXT`, `NUMBER`, `BOOLEAN` and `DATE`." +
+				" It cannot be updated for an existing state.",
+			Validators: []validator.String{
+				stringvalidator.OneOf(cidaas.AllowedGroupCustomFieldDataTypes...),
+			},
+			PlanModifiers: []planmodifier.String{
+				&validators.UniqueIdentifier{},
+			},
+		},
+		"required": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Indicates whether the field must be set on every user group it applies to. Defaults to `false`.",
+			Default:             booldefault.StaticBool(false),
+		},
+		"group_types": schema.SetAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			MarkdownDescription: "The group types the field applies to. When omitted, the field applies to user groups of any group type." +
+				" The values must be existing group types, for example the `group_type` of a `cidaas_group_type` resource.",
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "The timestamp when the resource was created.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "The timestamp when the resource was last updated.",
+		},
+	},
+}
+
+type GroupCustomFieldIdentity struct {
+	FieldKey types.String `tfsdk:"field_key"`
+}
+
+func (r *GroupCustomFieldResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"field_key": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique identifier of the group custom field.",
+			},
+		},
+	}
+}
+
+func (r *GroupCustomFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
+	var plan GroupCustomFieldConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get plan data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	field := cidaas.GroupCustomFieldModel{
+		FieldKey: plan.FieldKey.ValueString(),
+		DataType: plan.DataType.ValueString(),
+		Required: plan.Required.ValueBool(),
+	}
+	resp.Diagnostics.Append(plan.GroupTypes.ElementsAs(ctx, &field.GroupTypes, false)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to extract group types", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	res, err := r.cidaasClient.GroupFields.Create(ctx, field)
+	if err != nil {
+		tflog.Error(ctx, "failed to create group custom field via API", util.H{
+			"field_key": field.FieldKey,
+			"error":     err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to create group custom field", util.FormatErrorMessage(err))
+		return
+	}
+	tflog.Info(ctx, "successfully created group custom field via API", util.H{
+		"field_key": res.Data.FieldKey,
+	})
+
+	plan.ID = util.StringValueOrNull(&res.Data.ID)
+	plan.CreatedAt = util.StringValueOrNull(&res.Data.CreatedTime)
+	plan.UpdatedAt = util.StringValueOrNull(&res.Data.UpdatedTime)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, GroupCustomFieldIdentity{FieldKey: plan.FieldKey})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "resource group_custom_field created successfully", util.H{
+		"field_key": plan.FieldKey.ValueString(),
+	})
+}
+
+func (r *GroupCustomFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
+	var state GroupCustomFieldConfig
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get state data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	res, err := r.cidaasClient.GroupFields.Get(ctx, state.FieldKey.ValueString())
+	if err != nil {
+		tflog.Error(ctx, "failed to read group custom field via API", util.H{
+			"field_key": state.FieldKey.ValueString(),
+			"error":     err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to read group custom field", util.FormatErrorMessage(err))
+		return
+	}
+
+	state.ID = util.StringValueOrNull(&res.Data.ID)
+	state.FieldKey = util.StringValueOrNull(&res.Data.FieldKey)
+	state.DataType = util.StringValueOrNull(&res.Data.DataType)
+	state.Required = types.BoolValue(res.Data.Required)
+	state.GroupTypes = util.SetValueOrNull(res.Data.GroupTypes)
+	state.CreatedAt = util.StringValueOrNull(&res.Data.CreatedTime)
+	state.UpdatedAt = util.StringValueOrNull(&res.Data.UpdatedTime)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, GroupCustomFieldIdentity{FieldKey: state.FieldKey})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Debug(ctx, "resource group_custom_field read successfully", util.H{
+		"field_key": res.Data.FieldKey,
+	})
+}
+
+func (r *GroupCustomFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
+	var plan, state GroupCustomFieldConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get plan or state data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	field := cidaas.GroupCustomFieldModel{
+		ID:       state.ID.ValueString(),
+		FieldKey: plan.FieldKey.ValueString(),
+		DataType: plan.DataType.ValueString(),
+		Required: plan.Required.ValueBool(),
+	}
+	resp.Diagnostics.Append(plan.GroupTypes.ElementsAs(ctx, &field.GroupTypes, false)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to extract group types for update", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	res, err := r.cidaasClient.GroupFields.Update(ctx, field)
+	if err != nil {
+		tflog.Error(ctx, "failed to update group custom field via API", util.H{
+			"field_key": field.FieldKey,
+			"error":     err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to update group custom field", util.FormatErrorMessage(err))
+		return
+	}
+	tflog.Info(ctx, "successfully updated group custom field via API", util.H{
+		"field_key": field.FieldKey,
+	})
+
+	plan.UpdatedAt = util.StringValueOrNull(&res.Data.UpdatedTime)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, GroupCustomFieldIdentity{FieldKey: plan.FieldKey})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state after update", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Debug(ctx, "resource group_custom_field updated successfully", util.H{
+		"field_key": field.FieldKey,
+	})
+}
+
+func (r *GroupCustomFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
+	var state GroupCustomFieldConfig
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get state data for deletion", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	err := r.cidaasClient.GroupFields.Delete(ctx, state.FieldKey.ValueString())
+	if err != nil {
+		tflog.Error(ctx, "failed to delete group custom field via API", util.H{
+			"field_key": state.FieldKey.ValueString(),
+			"error":     err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to delete group custom field", util.FormatErrorMessage(err))
+		return
+	}
+
+	tflog.Info(ctx, "resource group_custom_field deleted successfully", util.H{
+		"field_key": state.FieldKey.ValueString(),
+	})
+}
+
+func (r *GroupCustomFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("field_key"), path.Root("field_key"), req, resp)
+}
@@ -0,0 +1,201 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	acctest "github.com/Cidaas/terraform-provider-cidaas/internal/test"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// create, read, import and update test
+func TestAccGroupCustomFieldResource_Basic(t *testing.T) {
+	t.Parallel()
+
+	groupType := acctest.RandString(10)
+	fieldKey := acctest.RandString(10)
+	testResourceID := acctest.RandString(10)
+	testResourceName := fmt.Sprintf("%s.%s", resources.RESOURCE_GROUP_CUSTOM_FIELD, testResourceID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckGroupCustomFieldDestroyed(testResourceName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupCustomFieldResourceConfig(testResourceID, groupType, fieldKey, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "field_key", fieldKey),
+					resource.TestCheckResourceAttr(testResourceName, "data_type", "TEXT"),
+					resource.TestCheckResourceAttr(testResourceName, "required", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "group_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(testResourceName, "group_types.*", groupType),
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "created_at"),
+				),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           fieldKey,
+				ImportStateVerifyIgnore: []string{"created_at", "updated_at"},
+			},
+			{
+				Config: testAccGroupCustomFieldResourceConfig(testResourceID, groupType, fieldKey, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "required", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGroupCustomFieldResourceConfig(resourceID, groupType, fieldKey string, required bool) string {
+	return fmt.Sprintf(`
+		provider "cidaas" {
+			base_url = "%s"
+		}
+		resource "cidaas_group_type" "%s" {
+			group_type  = "%s"
+			role_mode   = "no_roles"
+			description = "group type description"
+		}
+		resource "cidaas_group_custom_field" "%s" {
+			field_key   = "%s"
+			data_type   = "TEXT"
+			required    = %t
+			group_types = [cidaas_group_type.%s.group_type]
+		}
+	`, acctest.GetBaseURL(), resourceID, groupType, resourceID, fieldKey, required, resourceID)
+}
+
+func testCheckGroupCustomFieldDestroyed(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+
+		field := cidaas.GroupCustomField{
+			ClientConfig: cidaas.ClientConfig{
+				BaseURL:     acctest.GetBaseURL(),
+				AccessToken: acctest.TestToken,
+			},
+		}
+		res, err := field.Get(context.Background(), rs.Primary.Attributes["field_key"])
+		if err != nil {
+			if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") {
+				return nil
+			}
+			return fmt.Errorf("error checking if group custom field exists: %w", err)
+		}
+		if res != nil && res.Data.FieldKey != "" {
+			return fmt.Errorf("group custom field %s still exists", res.Data.FieldKey)
+		}
+		return nil
+	}
+}
+
+// data_type must be one of the allowed values
+func TestAccGroupCustomFieldResource_InvalidDataType(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				provider "cidaas" {
+					base_url = "%s"
+				}
+				resource "cidaas_group_custom_field" "sample" {
+					field_key = "%s"
+					data_type = "SELECT"
+				}
+				`, acctest.GetBaseURL(), acctest.RandString(10)),
+				ExpectError: regexp.MustCompile(`Attribute data_type value must be one of`),
+			},
+		},
+	})
+}
+
+// custom_fields of a user group are validated against the defined fields during plan
+func TestAccGroupCustomFieldResource_UserGroupValidation(t *testing.T) {
+	t.Parallel()
+
+	groupType := acctest.RandString(10)
+	fieldKey := acctest.RandString(10)
+	testResourceID := acctest.RandString(10)
+	baseConfig := testAccGroupCustomFieldResourceConfig(testResourceID, groupType, fieldKey, true)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: baseConfig,
+			},
+			{
+				Config: baseConfig + fmt.Sprintf(`
+				resource "cidaas_user_group" "%s" {
+					group_type    = cidaas_group_type.%s.group_type
+					group_id      = "%s"
+					group_name    = "%s"
+					custom_fields = {}
+				}
+				`, testResourceID, testResourceID, acctest.RandString(10), acctest.RandString(10)),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`The custom field %s is required`, fieldKey)),
+			},
+		},
+	})
+}
+
+// a custom field created in the same apply as the user group using it is accepted, even if the tenant
+// already defines other group custom fields
+func TestAccGroupCustomFieldResource_CreatedWithUserGroup(t *testing.T) {
+	t.Parallel()
+
+	groupType := acctest.RandString(10)
+	existingFieldKey := acctest.RandString(10)
+	fieldKey := acctest.RandString(10)
+	testResourceID := acctest.RandString(10)
+	baseConfig := testAccGroupCustomFieldResourceConfig(testResourceID, groupType, existingFieldKey, false)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: baseConfig,
+			},
+			{
+				Config: baseConfig + fmt.Sprintf(`
+				resource "cidaas_group_custom_field" "new" {
+					field_key   = "%s"
+					data_type   = "TEXT"
+					group_types = [cidaas_group_type.%s.group_type]
+				}
+				resource "cidaas_user_group" "%s" {
+					group_type    = cidaas_group_type.%s.group_type
+					group_id      = "%s"
+					group_name    = "%s"
+					custom_fields = {
+						(cidaas_group_custom_field.new.field_key) = cidaas_group_custom_field.new.id
+					}
+				}
+				`, fieldKey, testResourceID, testResourceID, testResourceID, acctest.RandString(10), acctest.RandString(10)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(fmt.Sprintf("%s.%s", resources.RESOURCE_USER_GROUP, testResourceID), "custom_fields."+fieldKey),
+				),
+			},
+		},
+	})
+}
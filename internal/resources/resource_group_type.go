@@ -8,10 +8,12 @@ import (
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
 	"github.com/Cidaas/terraform-provider-cidaas/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -36,13 +38,14 @@ func NewGroupTypeResource() resource.Resource {
 }
 
 type GroupTypeConfig struct {
-	ID           types.String `tfsdk:"id"`
-	RoleMode     types.String `tfsdk:"role_mode"`
-	Description  types.String `tfsdk:"description"`
-	GroupType    types.String `tfsdk:"group_type"`
-	AllowedRoles types.Set    `tfsdk:"allowed_roles"`
-	CreatedAt    types.String `tfsdk:"created_at"`
-	UpdatedAt    types.String `tfsdk:"updated_at"`
+	ID           types.String   `tfsdk:"id"`
+	RoleMode     types.String   `tfsdk:"role_mode"`
+	Description  types.String   `tfsdk:"description"`
+	GroupType    types.String   `tfsdk:"group_type"`
+	AllowedRoles types.Set      `tfsdk:"allowed_roles"`
+	CreatedAt    types.String   `tfsdk:"created_at"`
+	UpdatedAt    types.String   `tfsdk:"updated_at"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 var groupTypeSchema = schema.Schema{
@@ -106,7 +109,25 @@ var groupTypeSchema = schema.Schema{
 	},
 }
 
+type GroupTypeIdentity struct {
+	GroupType types.String `tfsdk:"group_type"`
+}
+
+func (r *GroupTypeResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"group_type": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique identifier of the group type.",
+			},
+		},
+	}
+}
+
 func (r *GroupTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
 	var plan GroupTypeConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -147,6 +168,7 @@ func (r *GroupTypeResource) Create(ctx context.Context, req resource.CreateReque
 	plan.UpdatedAt = util.StringValueOrNull(&res.Data.UpdatedTime)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, GroupTypeIdentity{GroupType: plan.GroupType})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -157,6 +179,9 @@ func (r *GroupTypeResource) Create(ctx context.Context, req resource.CreateReque
 }
 
 func (r *GroupTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
 	var state GroupTypeConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -185,6 +210,7 @@ func (r *GroupTypeResource) Read(ctx context.Context, req resource.ReadRequest,
 	state.AllowedRoles = util.SetValueOrNull(res.Data.AllowedRoles)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, GroupTypeIdentity{GroupType: state.GroupType})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -199,6 +225,9 @@ func (r *GroupTypeResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *GroupTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
 	var plan, state GroupTypeConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -237,6 +266,7 @@ func (r *GroupTypeResource) Update(ctx context.Context, req resource.UpdateReque
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, GroupTypeIdentity{GroupType: plan.GroupType})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state after update", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -251,6 +281,9 @@ func (r *GroupTypeResource) Update(ctx context.Context, req resource.UpdateReque
 }
 
 func (r *GroupTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
 	var state GroupTypeConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -275,7 +308,7 @@ func (r *GroupTypeResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *GroupTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("group_type"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("group_type"), path.Root("group_type"), req, resp)
 }
 
 type allowedRolesValidator struct{}
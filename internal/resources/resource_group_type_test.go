@@ -3,7 +3,6 @@ package resources_test
 import (
 	"context"
 	"fmt"
-	"os"
 	"regexp"
 	"strings"
 	"testing"
@@ -83,7 +82,7 @@ func testCheckGroupTypeDestroyed(resourceName string) resource.TestCheckFunc {
 
 		groupType := cidaas.GroupType{
 			ClientConfig: cidaas.ClientConfig{
-				BaseURL:     os.Getenv("BASE_URL"),
+				BaseURL:     acctest.GetBaseURL(),
 				AccessToken: acctest.TestToken,
 			},
 		}
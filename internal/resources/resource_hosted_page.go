@@ -2,16 +2,24 @@ package resources
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
 
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
 	"github.com/Cidaas/terraform-provider-cidaas/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -51,8 +59,9 @@ type HostedPageConfig struct {
 	DefaultLocale       types.String `tfsdk:"default_locale"`
 	HostedPages         types.Set    `tfsdk:"hosted_pages"`
 	hostedPages         []*HostedPage
-	CreatedAt           types.String `tfsdk:"created_at"`
-	UpdatedAt           types.String `tfsdk:"updated_at"`
+	CreatedAt           types.String   `tfsdk:"created_at"`
+	UpdatedAt           types.String   `tfsdk:"updated_at"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 type HostedPage struct {
@@ -60,6 +69,51 @@ type HostedPage struct {
 	Locale       types.String `tfsdk:"locale"`
 	URL          types.String `tfsdk:"url"`
 	Content      types.String `tfsdk:"content"`
+	SourceFile   types.String `tfsdk:"source_file"`
+	SourceDir    types.String `tfsdk:"source_dir"`
+	ContentHash  types.String `tfsdk:"content_hash"`
+}
+
+var hostedPageObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"hosted_page_id": types.StringType,
+		"locale":         types.StringType,
+		"url":            types.StringType,
+		"content":        types.StringType,
+		"source_file":    types.StringType,
+		"source_dir":     types.StringType,
+		"content_hash":   types.StringType,
+	},
+}
+
+// hasSource reports whether the content of the hosted page is read from a local file.
+func (hp *HostedPage) hasSource() bool {
+	return !hp.SourceFile.IsNull() || !hp.SourceDir.IsNull()
+}
+
+// sourcePath returns the local file of the hosted page. With source_dir the file is <source_dir>/<hosted_page_id>.html.
+func (hp *HostedPage) sourcePath() string {
+	if !hp.SourceFile.IsNull() {
+		return hp.SourceFile.ValueString()
+	}
+	return filepath.Join(hp.SourceDir.ValueString(), hp.HostedPageID.ValueString()+".html")
+}
+
+// resolveContent returns the content of the hosted page, either inline or read from the local source file.
+func (hp *HostedPage) resolveContent() (string, error) {
+	if !hp.hasSource() {
+		return hp.Content.ValueString(), nil
+	}
+	content, err := os.ReadFile(hp.sourcePath())
+	if err != nil {
+		return "", fmt.Errorf("failed to read the content of the hosted page %s for the locale %s: %w", hp.HostedPageID.ValueString(), hp.Locale.ValueString(), err)
+	}
+	return string(content), nil
+}
+
+func hostedPageContentHash(content string) string {
+	hash := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(hash[:])
 }
 
 func (h *HostedPageConfig) extractHostedPages(ctx context.Context) diag.Diagnostics {
@@ -72,6 +126,13 @@ func (h *HostedPageConfig) extractHostedPages(ctx context.Context) diag.Diagnost
 	return diags
 }
 
+// flattenHostedPages sets hosted_pages from the extracted hosted pages, e.g. after the content_hash was resolved.
+func (h *HostedPageConfig) flattenHostedPages(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+	h.HostedPages, diags = types.SetValueFrom(ctx, hostedPageObjectType, h.hostedPages)
+	return diags
+}
+
 var hostedPageSchema = schema.Schema{
 	MarkdownDescription: "The Hosted Page resource in the provider allows you to define and manage hosted pages within the Cidaas system." +
 		"\n\n Ensure that the below scopes are assigned to the client with the specified `client_id`:" +
@@ -99,7 +160,7 @@ var hostedPageSchema = schema.Schema{
 		"default_locale": schema.StringAttribute{
 			Optional:            true,
 			Computed:            true,
-			MarkdownDescription: "The default locale for hosted pages e.g. `en-US`.",
+			MarkdownDescription: "The default locale for hosted pages e.g. `en-US`. Every `hosted_page_id` in `hosted_pages` must have an entry with the default locale.",
 			Default:             stringdefault.StaticString("en"),
 			Validators: []validator.String{
 				stringvalidator.OneOf(
@@ -111,8 +172,6 @@ var hostedPageSchema = schema.Schema{
 						return validLocals
 					}()...),
 			},
-			// if hosted_page not found by the local provided in the hosted_pages map, the api throws ambigious data error.
-			// TODO: add a custom plan modifier later to validate the same and throw plan time error
 		},
 		"hosted_pages": schema.SetNestedAttribute{
 			Required:            true,
@@ -132,8 +191,7 @@ var hostedPageSchema = schema.Schema{
 					"locale": schema.StringAttribute{
 						Optional:            true,
 						Computed:            true,
-						MarkdownDescription: "The locale for the hosted page, e.g., `en-US`.",
-						Default:             stringdefault.StaticString("en"),
+						MarkdownDescription: "The locale for the hosted page, e.g., `en-US`. Defaults to `en`.",
 						Validators: []validator.String{
 							stringvalidator.OneOf(
 								func() []string {
@@ -151,7 +209,35 @@ var hostedPageSchema = schema.Schema{
 					},
 					"content": schema.StringAttribute{
 						Optional:            true,
-						MarkdownDescription: "The conent of the hosted page.",
+						MarkdownDescription: "The conent of the hosted page. Conflicts with `source_file` and `source_dir`.",
+					},
+					"source_file": schema.StringAttribute{
+						Optional: true,
+						MarkdownDescription: "The path to a local file whose content is uploaded as the content of the hosted page, e.g. `${path.module}/pages/login.html`." +
+							" The content is not stored in the state, changes are detected by `content_hash`. Conflicts with `content` and `source_dir`.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.ConflictsWith(
+								path.MatchRelative().AtParent().AtName("content"),
+								path.MatchRelative().AtParent().AtName("source_dir"),
+							),
+						},
+					},
+					"source_dir": schema.StringAttribute{
+						Optional: true,
+						MarkdownDescription: "The path to a local directory containing the file `<hosted_page_id>.html`, whose content is uploaded as the content of the hosted page." +
+							" This allows to keep the pages of a locale in one directory, e.g. `${path.module}/pages/en-US`." +
+							" The content is not stored in the state, changes are detected by `content_hash`. Conflicts with `content` and `source_file`.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.ConflictsWith(
+								path.MatchRelative().AtParent().AtName("content"),
+							),
+						},
+					},
+					"content_hash": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The SHA256 hash of the content of the hosted page, used to detect changes of the content in `source_file` and `source_dir`.",
 					},
 				},
 			},
@@ -173,12 +259,134 @@ var hostedPageSchema = schema.Schema{
 	},
 }
 
+func (r *HostedPageResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config HostedPageConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.DefaultLocale.IsUnknown() || config.HostedPages.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(config.extractHostedPages(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the locales default to en when not configured
+	defaultLocale := "en"
+	if !config.DefaultLocale.IsNull() {
+		defaultLocale = config.DefaultLocale.ValueString()
+	}
+	localesByPageID := map[string][]string{}
+	for _, hp := range config.hostedPages {
+		if hp.HostedPageID.IsUnknown() || hp.Locale.IsUnknown() {
+			return
+		}
+		locale := "en"
+		if !hp.Locale.IsNull() {
+			locale = hp.Locale.ValueString()
+		}
+		localesByPageID[hp.HostedPageID.ValueString()] = append(localesByPageID[hp.HostedPageID.ValueString()], locale)
+	}
+
+	var missing []string
+	for hostedPageID, locales := range localesByPageID {
+		if !util.Contains(locales, defaultLocale) {
+			missing = append(missing, hostedPageID)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_locale"),
+			"Unexpected Resource Configuration",
+			fmt.Sprintf("Every hosted_page_id must have an entry in hosted_pages with the default_locale %s. Missing entries for the hosted_page_ids %+v", defaultLocale, missing),
+		)
+	}
+}
+
+func (r *HostedPageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan HostedPageConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.HostedPages.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(plan.extractHostedPages(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the default of locale is set here instead of in the schema. A schema default is not applied reliably
+	// to set elements once content_hash differs from the config, which would replace a configured locale with en.
+	// An unknown locale in the plan is the unconfigured one unless the config itself has unknown locales
+	var config HostedPageConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(config.extractHostedPages(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	unknownLocaleConfigured := config.HostedPages.IsUnknown()
+	for _, hp := range config.hostedPages {
+		unknownLocaleConfigured = unknownLocaleConfigured || hp.Locale.IsUnknown()
+	}
+
+	// the hash is computed during plan so that a change of a source file shows up as a change of content_hash
+	for _, hp := range plan.hostedPages {
+		if hp.Locale.IsNull() || (hp.Locale.IsUnknown() && !unknownLocaleConfigured) {
+			hp.Locale = types.StringValue("en")
+		}
+		if hp.Content.IsUnknown() || hp.SourceFile.IsUnknown() || hp.SourceDir.IsUnknown() || hp.HostedPageID.IsUnknown() {
+			hp.ContentHash = types.StringUnknown()
+			continue
+		}
+		content, err := hp.resolveContent()
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("hosted_pages"), "Invalid Hosted Page Source", err.Error())
+			continue
+		}
+		hp.ContentHash = types.StringValue(hostedPageContentHash(content))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(plan.flattenHostedPages(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("hosted_pages"), plan.HostedPages)...)
+}
+
+type HostedPageIdentity struct {
+	HostedPageGroupName types.String `tfsdk:"hosted_page_group_name"`
+}
+
+func (r *HostedPageResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"hosted_page_group_name": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The name of the hosted page group.",
+			},
+		},
+	}
+}
+
 func (r *HostedPageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { //nolint:dupl
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
 	var plan HostedPageConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(plan.extractHostedPages(ctx)...)
 	hpPayload, diags := prepareHostedPageModel(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(plan.flattenHostedPages(ctx)...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to prepare hosted page model", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -202,6 +410,7 @@ func (r *HostedPageResource) Create(ctx context.Context, req resource.CreateRequ
 	plan.UpdatedAt = util.StringValueOrNull(&res.Data.UpdatedTime)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, HostedPageIdentity{HostedPageGroupName: plan.ID})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -215,6 +424,9 @@ func (r *HostedPageResource) Create(ctx context.Context, req resource.CreateRequ
 }
 
 func (r *HostedPageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
 	var state HostedPageConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -240,13 +452,16 @@ func (r *HostedPageResource) Read(ctx context.Context, req resource.ReadRequest,
 	state.CreatedAt = util.StringValueOrNull(&res.Data.CreatedTime)
 	state.UpdatedAt = util.StringValueOrNull(&res.Data.UpdatedTime)
 
-	hostedPages := types.ObjectType{
-		AttrTypes: map[string]attr.Type{
-			"hosted_page_id": types.StringType,
-			"locale":         types.StringType,
-			"url":            types.StringType,
-			"content":        types.StringType,
-		},
+	// the sources are not returned by the API and are carried over from the state
+	resp.Diagnostics.Append(state.extractHostedPages(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	sources := map[string]*HostedPage{}
+	for _, hp := range state.hostedPages {
+		if hp.hasSource() {
+			sources[hp.HostedPageID.ValueString()+":"+hp.Locale.ValueString()] = hp
+		}
 	}
 
 	var objectValues []attr.Value
@@ -254,17 +469,28 @@ func (r *HostedPageResource) Read(ctx context.Context, req resource.ReadRequest,
 		hostedPageID := sc.HostedPageID
 		local := sc.Locale
 		url := sc.URL
-		content := sc.Content
-		objValue := types.ObjectValueMust(hostedPages.AttrTypes, map[string]attr.Value{
+		content := util.StringValueOrNull(&sc.Content)
+		sourceFile := types.StringNull()
+		sourceDir := types.StringNull()
+		if source, ok := sources[hostedPageID+":"+local]; ok {
+			// the content of a source file is kept out of the state, content_hash reflects changes in cidaas
+			content = types.StringNull()
+			sourceFile = source.SourceFile
+			sourceDir = source.SourceDir
+		}
+		objValue := types.ObjectValueMust(hostedPageObjectType.AttrTypes, map[string]attr.Value{
 			"hosted_page_id": util.StringValueOrNull(&hostedPageID),
 			"locale":         util.StringValueOrNull(&local),
 			"url":            util.StringValueOrNull(&url),
-			"content":        util.StringValueOrNull(&content),
+			"content":        content,
+			"source_file":    sourceFile,
+			"source_dir":     sourceDir,
+			"content_hash":   types.StringValue(hostedPageContentHash(sc.Content)),
 		})
 		objectValues = append(objectValues, objValue)
 	}
 
-	hps, diags := types.SetValueFrom(ctx, hostedPages, objectValues)
+	hps, diags := types.SetValueFrom(ctx, hostedPageObjectType, objectValues)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to process hosted pages data", util.H{
@@ -276,6 +502,7 @@ func (r *HostedPageResource) Read(ctx context.Context, req resource.ReadRequest,
 	tflog.Debug(ctx, "successfully processed hosted pages data")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, HostedPageIdentity{HostedPageGroupName: state.ID})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -289,6 +516,9 @@ func (r *HostedPageResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *HostedPageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { //nolint:dupl
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
 	var plan, state HostedPageConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -298,6 +528,7 @@ func (r *HostedPageResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 	hpPayload, diags := prepareHostedPageModel(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(plan.flattenHostedPages(ctx)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -307,9 +538,13 @@ func (r *HostedPageResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, HostedPageIdentity{HostedPageGroupName: plan.ID})...)
 }
 
 func (r *HostedPageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
 	var state HostedPageConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -335,7 +570,7 @@ func (r *HostedPageResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *HostedPageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("hosted_page_group_name"), req, resp)
 }
 
 func prepareHostedPageModel(_ context.Context, plan HostedPageConfig) (*cidaas.HostedPageModel, diag.Diagnostics) {
@@ -344,15 +579,30 @@ func prepareHostedPageModel(_ context.Context, plan HostedPageConfig) (*cidaas.H
 		DefaultLocale: plan.DefaultLocale.ValueString(),
 		GroupOwner:    GroupOwner,
 	}
+	var diags diag.Diagnostics
 	var hps []cidaas.HostedPageData
 	for _, hp := range plan.hostedPages {
+		content, err := hp.resolveContent()
+		if err != nil {
+			diags.AddError("failed to read hosted page source", err.Error())
+			return nil, diags
+		}
+		// the source file must not change between plan and apply, otherwise the uploaded content differs from the plan
+		if hp.hasSource() && !hp.ContentHash.IsUnknown() && hp.ContentHash.ValueString() != hostedPageContentHash(content) {
+			diags.AddError(
+				"hosted page source changed after plan",
+				fmt.Sprintf("The content of %s changed after the plan was created. Please run terraform plan again.", hp.sourcePath()),
+			)
+			return nil, diags
+		}
+		hp.ContentHash = types.StringValue(hostedPageContentHash(content))
 		hps = append(hps, cidaas.HostedPageData{
 			HostedPageID: hp.HostedPageID.ValueString(),
 			Locale:       hp.Locale.ValueString(),
 			URL:          hp.URL.ValueString(),
-			Content:      hp.Content.ValueString(),
+			Content:      content,
 		})
 	}
 	hostedPage.HostedPages = hps
-	return &hostedPage, nil
+	return &hostedPage, diags
 }
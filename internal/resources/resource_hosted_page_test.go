@@ -2,8 +2,11 @@ package resources_test
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"testing"
@@ -144,7 +147,7 @@ func testCheckHostedPageDestroyed(resourceName string) resource.TestCheckFunc {
 
 		hp := cidaas.HostedPage{
 			ClientConfig: cidaas.ClientConfig{
-				BaseURL:     os.Getenv("BASE_URL"),
+				BaseURL:     acctest.GetBaseURL(),
 				AccessToken: acctest.TestToken,
 			},
 		}
@@ -205,6 +208,116 @@ func TestAccHostedPageResource_InvalidLocale(t *testing.T) {
 	})
 }
 
+// every hosted_page_id must have an entry with the default_locale
+func TestAccHostedPageResource_DefaultLocaleEntryMissing(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccHostedPageResourceConfig(acctest.RandString(10), "en-IN", acctest.RandString(10), hostedPages),
+				ExpectError: regexp.MustCompile("Missing entries for the hosted_page_ids"),
+			},
+		},
+	})
+}
+
+// content uploaded from a source file, a change of the file is detected by content_hash
+func TestAccHostedPageResource_SourceFile(t *testing.T) {
+	t.Parallel()
+
+	resourceID := acctest.RandString(10)
+	testResourceName := fmt.Sprintf("%s.%s", resources.RESOURCE_HOSTED_PAGE, resourceID)
+	sourceFile := filepath.Join(t.TempDir(), "register_success.html")
+	updatedContent := "<html>Updated Register Success</html>"
+
+	writeSource := func(content string) {
+		if err := os.WriteFile(sourceFile, []byte(content), 0o600); err != nil {
+			t.Fatalf("failed to write source file: %s", err)
+		}
+	}
+	contentHash := func(content string) string {
+		hash := sha256.Sum256([]byte(content))
+		return hex.EncodeToString(hash[:])
+	}
+	config := fmt.Sprintf(`
+		provider "cidaas" {
+			base_url = "%s"
+		}
+		resource "cidaas_hosted_page" "%s" {
+			hosted_page_group_name = "%s"
+			default_locale         = "%s"
+			hosted_pages = [
+				{
+					hosted_page_id = "%s"
+					locale         = "%s"
+					url            = "%s"
+					source_file    = "%s"
+				}
+			]
+		}
+	`, acctest.GetBaseURL(), resourceID, acctest.RandString(10), defaultLocale, hostedPageID, defaultLocale, hostedPageURL, filepath.ToSlash(sourceFile))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckHostedPageDestroyed(testResourceName),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { writeSource(hostedPageContent) },
+				Config:    config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(testResourceName, "hosted_pages.0.content"),
+				),
+			},
+			{
+				PreConfig: func() { writeSource(updatedContent) },
+				Config:    config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "hosted_pages.0.content_hash", contentHash(updatedContent)),
+				),
+			},
+		},
+	})
+}
+
+// the locales default to en when not configured
+func TestAccHostedPageResource_DefaultLocale(t *testing.T) {
+	t.Parallel()
+
+	resourceID := acctest.RandString(10)
+	testResourceName := fmt.Sprintf("%s.%s", resources.RESOURCE_HOSTED_PAGE, resourceID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckHostedPageDestroyed(testResourceName),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					provider "cidaas" {
+						base_url = "%s"
+					}
+					resource "cidaas_hosted_page" "%s" {
+						hosted_page_group_name = "%s"
+						hosted_pages = [{
+							hosted_page_id = "%s"
+							url            = "%s"
+							content        = "%s"
+						}]
+					}
+				`, acctest.GetBaseURL(), resourceID, acctest.RandString(10), hostedPageID, hostedPageURL, hostedPageContent),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "default_locale", "en"),
+					resource.TestCheckResourceAttr(testResourceName, "hosted_pages.0.locale", "en"),
+				),
+			},
+		},
+	})
+}
+
 // missing required fields validation
 func TestAccHostedPageResource_MissingRequiredFields(t *testing.T) {
 	t.Parallel()
@@ -6,11 +6,13 @@ import (
 
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -44,6 +46,7 @@ type PasswordPolicyConfig struct {
 	PasswordPolicy types.Object `tfsdk:"password_policy"`
 
 	passwordPolicy *PasswordPolicyMap
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
 type PasswordPolicyMap struct {
@@ -146,7 +149,25 @@ var passwordPolicySchema = schema.Schema{
 	},
 }
 
+type PasswordPolicyIdentity struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *PasswordPolicy) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique identifier of the password policy.",
+			},
+		},
+	}
+}
+
 func (r *PasswordPolicy) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
 	var plan PasswordPolicyConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(plan.extract(ctx)...)
@@ -196,6 +217,7 @@ func (r *PasswordPolicy) Create(ctx context.Context, req resource.CreateRequest,
 	plan.ID = types.StringValue(res.Data.ID)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, PasswordPolicyIdentity{ID: plan.ID})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -210,6 +232,9 @@ func (r *PasswordPolicy) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *PasswordPolicy) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
 	var state PasswordPolicyConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -270,6 +295,7 @@ func (r *PasswordPolicy) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, PasswordPolicyIdentity{ID: state.ID})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -284,6 +310,9 @@ func (r *PasswordPolicy) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (r *PasswordPolicy) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
 	var plan, state PasswordPolicyConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -343,6 +372,7 @@ func (r *PasswordPolicy) Update(ctx context.Context, req resource.UpdateRequest,
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, PasswordPolicyIdentity{ID: plan.ID})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "Failed to set state after update", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -356,6 +386,9 @@ func (r *PasswordPolicy) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *PasswordPolicy) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
 	var state PasswordPolicyConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -380,5 +413,5 @@ func (r *PasswordPolicy) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *PasswordPolicy) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -3,16 +3,19 @@ package resources
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
 	"github.com/Cidaas/terraform-provider-cidaas/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -70,9 +73,11 @@ type RegFieldConfig struct {
 	ConsentRefs                         types.Set    `tfsdk:"consent_refs"`
 	LocalTexts                          types.List   `tfsdk:"local_texts"`
 	FieldDefinition                     types.Object `tfsdk:"field_definition"`
+	ForceDestroy                        types.Bool   `tfsdk:"force_destroy"`
 
 	localTexts      []*LocalTexts
 	fieldDefinition *FieldDefinition
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 type LocalTexts struct {
@@ -331,6 +336,13 @@ var regFieldSchema = schema.Schema{
 				},
 			},
 		},
+		"force_destroy": schema.BoolAttribute{
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "By default, the field is not deleted while it is referenced in the `required_fields` or `allowed_fields` of an app" +
+				" or the `required_fields` of a consent version. Set to `true` to delete the field regardless of the references. Defaults set to `false`",
+			Default: booldefault.StaticBool(false),
+		},
 		"field_definition": schema.SingleNestedAttribute{
 			Optional: true,
 			Computed: true,
@@ -436,7 +448,194 @@ func (rfc *RegFieldConfig) ExtractConfigs(ctx context.Context) diag.Diagnostics
 	return diags
 }
 
+var (
+	attributeDataTypes      = []string{"SELECT", "RADIO", "MULTISELECT"}
+	regexDataTypes          = []string{"TEXT", "URL"}
+	noMaxMinLengthDataTypes = []string{"CHECKBOX", "CONSENT", "JSON_STRING", "ARRAY", "NUMBER", "SELECT", "RADIO", "MULTISELECT", "MOBILE", "TEXT", "URL"}
+)
+
+func (r *RegFieldResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config RegFieldConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(config.ExtractConfigs(ctx)...)
+	if resp.Diagnostics.HasError() || config.DataType.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(config.validateFieldDefinition()...)
+	resp.Diagnostics.Append(config.validateLocalTexts()...)
+
+	if !config.ConsentRefs.IsNull() && config.DataType.ValueString() != "CONSENT" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("consent_refs"),
+			"Unexpected Resource Configuration",
+			fmt.Sprintf("Attribute consent_refs is only allowed when data_type is set to CONSENT, got: %s.", config.DataType.ValueString()),
+		)
+	}
+}
+
+// validateFieldDefinition checks the lengths, regex and dates of the field_definition against the data_type.
+func (rfc *RegFieldConfig) validateFieldDefinition() diag.Diagnostics {
+	var diags diag.Diagnostics
+	if rfc.fieldDefinition == nil {
+		return diags
+	}
+	dataType := rfc.DataType.ValueString()
+	fd := rfc.fieldDefinition
+	fdPath := path.Root("field_definition")
+
+	lengths := []struct {
+		name  string
+		value types.Int64
+	}{{"min_length", fd.MinLength}, {"max_length", fd.MaxLength}}
+	for _, length := range lengths {
+		if !length.value.IsNull() && util.Contains(noMaxMinLengthDataTypes, dataType) {
+			diags.AddAttributeError(
+				fdPath.AtName(length.name),
+				"Unexpected Resource Configuration",
+				fmt.Sprintf("Attribute %s is not allowed when the data_type is %s.", length.name, dataType),
+			)
+		}
+	}
+	if !fd.MinLength.IsNull() && !fd.MinLength.IsUnknown() && !fd.MaxLength.IsNull() && !fd.MaxLength.IsUnknown() &&
+		fd.MinLength.ValueInt64() > fd.MaxLength.ValueInt64() {
+		diags.AddAttributeError(
+			fdPath.AtName("min_length"),
+			"Invalid Field Length",
+			fmt.Sprintf("Attribute min_length %d must not be greater than max_length %d.", fd.MinLength.ValueInt64(), fd.MaxLength.ValueInt64()),
+		)
+	}
+
+	if !fd.Regex.IsNull() && !util.Contains(regexDataTypes, dataType) {
+		diags.AddAttributeError(
+			fdPath.AtName("regex"),
+			"Unexpected Resource Configuration",
+			fmt.Sprintf("Attribute regex is only allowed when data_type is TEXT or URL, got: %s.", dataType),
+		)
+	}
+
+	// invalid date formats are reported by the attribute validators
+	minDate, hasMinDate := parseRegFieldDate(fd.MinDate)
+	maxDate, hasMaxDate := parseRegFieldDate(fd.MaxDate)
+	initialDate, hasInitialDate := parseRegFieldDate(fd.InitialDate)
+	if hasMinDate && hasMaxDate && minDate.After(maxDate) {
+		diags.AddAttributeError(
+			fdPath.AtName("min_date"),
+			"Invalid Date Range",
+			fmt.Sprintf("Attribute min_date %s must not be after max_date %s.", fd.MinDate.ValueString(), fd.MaxDate.ValueString()),
+		)
+	}
+	if hasInitialDate && ((hasMinDate && initialDate.Before(minDate)) || (hasMaxDate && initialDate.After(maxDate))) {
+		diags.AddAttributeError(
+			fdPath.AtName("initial_date"),
+			"Invalid Date Range",
+			fmt.Sprintf("Attribute initial_date %s must be between min_date and max_date.", fd.InitialDate.ValueString()),
+		)
+	}
+	return diags
+}
+
+// validateLocalTexts checks the attributes and consent labels of the local_texts against the data_type.
+// Select fields need the same attribute keys in every locale.
+func (rfc *RegFieldConfig) validateLocalTexts() diag.Diagnostics {
+	var diags diag.Diagnostics
+	dataType := rfc.DataType.ValueString()
+
+	var expectedKeys []string
+	for i, localText := range rfc.localTexts {
+		ltPath := path.Root("local_texts").AtListIndex(i)
+		if localText.Attributes.IsUnknown() {
+			continue
+		}
+
+		keys := make([]string, 0, len(localText.attributes))
+		for _, attribute := range localText.attributes {
+			keys = append(keys, attribute.Key.ValueString())
+		}
+		switch {
+		case !util.Contains(attributeDataTypes, dataType) && !localText.Attributes.IsNull():
+			diags.AddAttributeError(
+				ltPath.AtName("attributes"),
+				"Unexpected Resource Configuration",
+				fmt.Sprintf("Attribute attributes is not allowed when the data_type is %s.", dataType),
+			)
+		case util.Contains(attributeDataTypes, dataType) && len(keys) == 0:
+			diags.AddAttributeError(
+				ltPath.AtName("attributes"),
+				"Missing Field Attributes",
+				fmt.Sprintf("Attribute attributes can not be empty when data_type is %s.", dataType),
+			)
+		case util.Contains(attributeDataTypes, dataType) && expectedKeys == nil:
+			expectedKeys = keys
+		case util.Contains(attributeDataTypes, dataType) && !sameStrings(expectedKeys, keys):
+			diags.AddAttributeError(
+				ltPath.AtName("attributes"),
+				"Mismatched Field Attributes",
+				fmt.Sprintf("The attribute keys %v of locale %s must match the keys %v of the first locale.",
+					keys, localText.Locale.ValueString(), expectedKeys),
+			)
+		}
+
+		if localText.ConsentLabel.IsUnknown() {
+			continue
+		}
+		if dataType == "CONSENT" && localText.ConsentLabel.IsNull() {
+			diags.AddAttributeError(
+				ltPath.AtName("consent_label"),
+				"Missing Consent Label",
+				"Attribute consent_label is required when data_type is CONSENT.",
+			)
+		}
+		if dataType != "CONSENT" && !localText.ConsentLabel.IsNull() {
+			diags.AddAttributeError(
+				ltPath.AtName("consent_label"),
+				"Unexpected Resource Configuration",
+				fmt.Sprintf("Attribute consent_label is only allowed when data_type is CONSENT, got: %s.", dataType),
+			)
+		}
+	}
+	return diags
+}
+
+func parseRegFieldDate(value types.String) (time.Time, bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return time.Time{}, false
+	}
+	date, err := time.Parse(layout, value.ValueString())
+	return date, err == nil
+}
+
+// sameStrings reports whether both slices contain the same values regardless of their order.
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, v := range a {
+		if !util.Contains(b, v) {
+			return false
+		}
+	}
+	return true
+}
+
+type RegFieldIdentity struct {
+	FieldKey types.String `tfsdk:"field_key"`
+}
+
+func (r *RegFieldResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"field_key": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique identifier of the registration field.",
+			},
+		},
+	}
+}
+
 func (r *RegFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
 	var plan RegFieldConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(plan.ExtractConfigs(ctx)...)
@@ -461,11 +660,14 @@ func (r *RegFieldResource) Create(ctx context.Context, req resource.CreateReques
 		"field_id": res.Data.ID,
 	})
 
+	r.waitForRegField(ctx, *rfModel, &resp.Diagnostics)
+
 	plan.ID = types.StringValue(res.Data.ID)
 	plan.Order = types.Int64Value(res.Data.Order)
 	plan.BaseDataType = types.StringValue(res.Data.BaseDataType)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, RegFieldIdentity{FieldKey: plan.FieldKey})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "Failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -480,6 +682,9 @@ func (r *RegFieldResource) Create(ctx context.Context, req resource.CreateReques
 }
 
 func (r *RegFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
 	var state RegFieldConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	res, err := r.cidaasClient.RegFields.Get(ctx, state.FieldKey.ValueString())
@@ -506,6 +711,9 @@ func (r *RegFieldResource) Read(ctx context.Context, req resource.ReadRequest, r
 	state.Scopes = util.SetValueOrNull(res.Data.Scopes)
 	state.ConsentRefs = util.SetValueOrNull(res.Data.ConsentRefs)
 	state.Order = util.Int64ValueOrNull(&res.Data.Order)
+	if state.ForceDestroy.IsNull() {
+		state.ForceDestroy = types.BoolValue(false)
+	}
 
 	var localTextsObjectValues []attr.Value
 	typesOfAttribute := map[string]attr.Type{
@@ -616,9 +824,13 @@ func (r *RegFieldResource) Read(ctx context.Context, req resource.ReadRequest, r
 		state.FieldDefinition = fd
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, RegFieldIdentity{FieldKey: state.FieldKey})...)
 }
 
 func (r *RegFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { //nolint:dupl
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
 	var plan, state RegFieldConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(plan.ExtractConfigs(ctx)...)
@@ -652,8 +864,10 @@ func (r *RegFieldResource) Update(ctx context.Context, req resource.UpdateReques
 	tflog.Info(ctx, "successfully updated registration field via API", util.H{
 		"field_id": state.ID.ValueString(),
 	})
+	r.waitForRegField(ctx, *fieldModel, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, RegFieldIdentity{FieldKey: plan.FieldKey})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state after update", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -668,6 +882,9 @@ func (r *RegFieldResource) Update(ctx context.Context, req resource.UpdateReques
 }
 
 func (r *RegFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
 	var state RegFieldConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -677,6 +894,22 @@ func (r *RegFieldResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	if !state.ForceDestroy.ValueBool() {
+		references, err := r.fieldReferences(ctx, state.FieldKey.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("failed to check registration field references", util.FormatErrorMessage(err))
+			return
+		}
+		if len(references) > 0 {
+			resp.Diagnostics.AddError(
+				"Registration Field In Use",
+				fmt.Sprintf("The registration field %s is still referenced by:\n- %s\n\nRemove the references or set force_destroy to true to delete the field anyway.",
+					state.FieldKey.ValueString(), strings.Join(references, "\n- ")),
+			)
+			return
+		}
+	}
+
 	err := r.cidaasClient.RegFields.Delete(ctx, state.FieldKey.ValueString())
 	if err != nil {
 		tflog.Error(ctx, "failed to delete registration field via API", util.H{
@@ -692,8 +925,65 @@ func (r *RegFieldResource) Delete(ctx context.Context, req resource.DeleteReques
 	})
 }
 
+// waitForRegField re-reads the registration field after a write until cidaas returns the written flags.
+func (r *RegFieldResource) waitForRegField(ctx context.Context, field cidaas.RegistrationFieldConfig, diags *diag.Diagnostics) {
+	waitForConsistency(ctx, "registration field "+field.FieldKey, func(ctx context.Context) (*cidaas.RegistrationFieldResponse, error) {
+		return r.cidaasClient.RegFields.Get(ctx, field.FieldKey)
+	}, func(res *cidaas.RegistrationFieldResponse) bool {
+		return res.Data.FieldKey == field.FieldKey &&
+			res.Data.Enabled == field.Enabled &&
+			res.Data.Required == field.Required &&
+			res.Data.ReadOnly == field.ReadOnly
+	}, diags)
+}
+
+// fieldReferences returns the apps and consent versions that reference the field key in their required or allowed fields.
+func (r *RegFieldResource) fieldReferences(ctx context.Context, fieldKey string) ([]string, error) {
+	var references []string
+
+	apps, err := r.cidaasClient.Apps.GetAll(ctx)
+	if err != nil {
+		tflog.Error(ctx, "failed to read apps via API", util.H{
+			"error": err.Error(),
+		})
+		return nil, err
+	}
+	for _, app := range apps {
+		if util.Contains(app.RequiredFields, fieldKey) {
+			references = append(references, fmt.Sprintf("app %s (client_id %s) in required_fields", app.ClientName, app.ClientID))
+		}
+		if util.Contains(app.AllowedFields, fieldKey) {
+			references = append(references, fmt.Sprintf("app %s (client_id %s) in allowed_fields", app.ClientName, app.ClientID))
+		}
+	}
+
+	consents, err := r.cidaasClient.Consent.GetAll(ctx)
+	if err != nil {
+		tflog.Error(ctx, "failed to read consents via API", util.H{
+			"error": err.Error(),
+		})
+		return nil, err
+	}
+	for _, consent := range consents {
+		versions, err := r.cidaasClient.ConsentVersion.Get(ctx, consent.ID)
+		if err != nil {
+			tflog.Error(ctx, "failed to read consent versions via API", util.H{
+				"consent_id": consent.ID,
+				"error":      err.Error(),
+			})
+			return nil, err
+		}
+		for _, version := range versions.Data {
+			if util.Contains(version.RequiredFields, fieldKey) {
+				references = append(references, fmt.Sprintf("consent %s (consent_id %s) version %v in required_fields", consent.ConsentName, consent.ID, version.Version))
+			}
+		}
+	}
+	return references, nil
+}
+
 func (r *RegFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("field_key"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("field_key"), path.Root("field_key"), req, resp)
 }
 
 func prepareRegFieldModel(ctx context.Context, plan RegFieldConfig) (*cidaas.RegistrationFieldConfig, diag.Diagnostics) { //nolint:gocognit
@@ -838,11 +1128,8 @@ func (v validateIsMaxMinMsgAvailableForRegex) ValidateString(ctx context.Context
 		var config RegFieldConfig
 		resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 		resp.Diagnostics.Append(config.ExtractConfigs(ctx)...)
-		if !util.Contains([]string{"TEXT", "URL"}, config.DataType.ValueString()) {
-			resp.Diagnostics.AddError(
-				"Validation Error",
-				fmt.Sprintf("The attribute %s is only allowed when data_type is TEXT or URL", req.Path.String()),
-			)
+		// the data type is validated in ValidateConfig
+		if !util.Contains(regexDataTypes, config.DataType.ValueString()) {
 			return
 		}
 
@@ -945,14 +1232,6 @@ func (v validateIsMaxMinMsgAvailable) ValidateInt64(ctx context.Context, req val
 				)
 				return
 			}
-
-			if config.fieldDefinition.MaxLength.ValueInt64() < config.fieldDefinition.MinLength.ValueInt64() {
-				resp.Diagnostics.AddError(
-					"Validation Error",
-					fmt.Sprintf("The attribute field_definition.max_length can not be less than %s", req.Path.String()),
-				)
-				return
-			}
 		}
 		if req.Path.String() == "field_definition.max_length" {
 			for _, v := range config.localTexts {
@@ -1057,54 +1336,6 @@ func (v dataTypeValidator) ValidateString(ctx context.Context, req validator.Str
 			"Attributes min_date, max_date, initial_date and initial_date_view can not be empty when data_type is DATE.",
 		)
 	}
-
-	if req.ConfigValue.ValueString() != "CONSENT" &&
-		!config.ConsentRefs.IsNull() {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configuration",
-			"Attribute consent_refs is only allowed when data_type is set to CONSENT.",
-		)
-	}
-
-	attrKeysRequiredDataTypes := []string{"SELECT", "RADIO", "MULTISELECT"}
-	if util.Contains(attrKeysRequiredDataTypes, req.ConfigValue.ValueString()) {
-		for _, s := range config.localTexts {
-			if !s.Attributes.IsNull() && !s.Attributes.IsUnknown() && !(len(s.attributes) > 0) {
-				resp.Diagnostics.AddError(
-					"Unexpected Resource Configuration",
-					fmt.Sprintf("Attributes local_texts[i].attributes can not be empty when data_type is %s.", req.ConfigValue.ValueString()),
-				)
-			}
-		}
-	}
-
-	noMaxMinLengthDataTypes := []string{"CHECKBOX", "CONSENT", "JSON_STRING", "ARRAY", "NUMBER", "SELECT", "RADIO", "MULTISELECT", "MOBILE", "JSON_STRING", "TEXT", "URL"}
-	if util.Contains(noMaxMinLengthDataTypes, req.ConfigValue.ValueString()) {
-		if config.FieldDefinition.IsNull() {
-			return
-		}
-		if !config.fieldDefinition.MinLength.IsNull() || !config.fieldDefinition.MaxLength.IsNull() {
-			resp.Diagnostics.AddError(
-				"Unexpected Resource Configuration",
-				fmt.Sprintf("Attributes min_length, max_length are not allowed in config when the data_type is %s.", req.ConfigValue.ValueString()),
-			)
-		}
-	}
-
-	noAttributesDataTypes := []string{
-		"TEXT", "NUMBER", "CHECKBOX", "PASSWORD", "DATE", "URL", "EMAIL",
-		"TEXTAREA", "MOBILE", "CONSENT", "JSON_STRING", "USERNAME", "ARRAY", "GROUPING", "DAYDATE",
-	}
-	if util.Contains(noAttributesDataTypes, req.ConfigValue.ValueString()) {
-		for _, v := range config.localTexts {
-			if len(v.attributes) > 0 {
-				resp.Diagnostics.AddError(
-					"Unexpected Resource Configuration",
-					fmt.Sprintf("param local_texts[i].attributes not allowed in config when the data_type is %s.", req.ConfigValue.ValueString()),
-				)
-			}
-		}
-	}
 }
 
 func (v isGroupValidator) Description(_ context.Context) string {
@@ -0,0 +1,340 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const defaultRegFieldGroup = "DEFAULT"
+
+type RegFieldOrderResource struct {
+	BaseResource
+}
+
+func NewRegFieldOrderResource() resource.Resource {
+	return &RegFieldOrderResource{
+		BaseResource: NewBaseResource(
+			BaseResourceConfig{
+				Name:   RESOURCE_REGISTRATION_FIELD_ORDER,
+				Schema: &regFieldOrderSchema,
+			},
+		),
+	}
+}
+
+type RegFieldOrderConfig struct {
+	ID            types.String   `tfsdk:"id"`
+	ParentGroupID types.String   `tfsdk:"parent_group_id"`
+	FieldKeys     types.List     `tfsdk:"field_keys"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+var regFieldOrderSchema = schema.Schema{
+	MarkdownDescription: "The `cidaas_registration_field_order` resource manages the order of the registration fields of a parent group on the registration form." +
+		" The fields listed in `field_keys` are placed first in the given order, the remaining fields of the group keep their relative order behind them." +
+		" Reordering the listed fields or moving another field ahead of or between them in the admin UI is detected as drift and reverted on the next apply." +
+		"\n\n Destroying the resource only removes it from the state, the order of the fields is not changed." +
+		"\n\n Ensure that the below scopes are assigned to the client with the specified `client_id`:" +
+		"\n- cidaas:field_setup_read" +
+		"\n- cidaas:field_setup_write",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the resource. It is the same as the parent_group_id.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"parent_group_id": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "The ID of the parent registration group whose fields are ordered. Defaults to `DEFAULT`. It cannot be updated for an existing state.",
+			Default:             stringdefault.StaticString(defaultRegFieldGroup),
+			PlanModifiers: []planmodifier.String{
+				&validators.UniqueIdentifier{},
+			},
+		},
+		"field_keys": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Required:            true,
+			MarkdownDescription: "The ordered list of field keys. All fields must belong to the `parent_group_id`.",
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+	},
+}
+
+type RegFieldOrderIdentity struct {
+	ParentGroupID types.String `tfsdk:"parent_group_id"`
+}
+
+func (r *RegFieldOrderResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"parent_group_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the registration field group whose fields are ordered.",
+			},
+		},
+	}
+}
+
+func (r *RegFieldOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
+	var plan RegFieldOrderConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get plan data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyOrder(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.ParentGroupID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, RegFieldOrderIdentity{ParentGroupID: plan.ParentGroupID})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "resource registration_field_order created successfully", util.H{
+		"parent_group_id": plan.ParentGroupID.ValueString(),
+	})
+}
+
+func (r *RegFieldOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
+	var state RegFieldOrderConfig
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get state data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	fields, err := r.groupFields(ctx, state.ParentGroupID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read registration field order", util.FormatErrorMessage(err))
+		return
+	}
+
+	var managed []string
+	resp.Diagnostics.Append(state.FieldKeys.ElementsAs(ctx, &managed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the fields of the group in their current order up to the last managed field, all fields of the group are
+	// managed after an import. The managed fields are placed first, so an unmanaged field which was moved ahead
+	// of or between them in the admin UI is kept in the state and the difference to the plan reverts the order.
+	end := len(fields)
+	if len(managed) > 0 {
+		end = 0
+		for i, field := range fields {
+			if util.Contains(managed, field.FieldKey) {
+				end = i + 1
+			}
+		}
+	}
+	fieldKeys := make([]string, 0, end)
+	for _, field := range fields[:end] {
+		fieldKeys = append(fieldKeys, field.FieldKey)
+	}
+	if len(fieldKeys) == 0 {
+		tflog.Warn(ctx, "no managed registration fields found in group, removing resource from state", util.H{
+			"parent_group_id": state.ParentGroupID.ValueString(),
+		})
+		// terraform requires the identity of a resource state which did not have one yet even if it is removed
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, RegFieldOrderIdentity{ParentGroupID: state.ParentGroupID})...)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var diags diag.Diagnostics
+	state.ID = state.ParentGroupID
+	state.FieldKeys, diags = types.ListValueFrom(ctx, types.StringType, fieldKeys)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, RegFieldOrderIdentity{ParentGroupID: state.ParentGroupID})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Debug(ctx, "resource registration_field_order read successfully", util.H{
+		"parent_group_id": state.ParentGroupID.ValueString(),
+		"fields_count":    len(fieldKeys),
+	})
+}
+
+func (r *RegFieldOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
+	var plan RegFieldOrderConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get plan data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyOrder(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, RegFieldOrderIdentity{ParentGroupID: plan.ParentGroupID})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state after update", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Debug(ctx, "resource registration_field_order updated successfully", util.H{
+		"parent_group_id": plan.ParentGroupID.ValueString(),
+	})
+}
+
+// Delete only removes the resource from the state as the fields of a group always have an order.
+func (r *RegFieldOrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
+	var state RegFieldOrderConfig
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "resource registration_field_order removed from state, the field order is not changed", util.H{
+		"parent_group_id": state.ParentGroupID.ValueString(),
+	})
+}
+
+func (r *RegFieldOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("parent_group_id"), path.Root("parent_group_id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("field_keys"), types.ListValueMust(types.StringType, nil))...)
+}
+
+// applyOrder places the planned fields first and the remaining fields of the group in their current order behind them.
+func (r *RegFieldOrderResource) applyOrder(ctx context.Context, plan RegFieldOrderConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+	parentGroupID := plan.ParentGroupID.ValueString()
+
+	var fieldKeys []string
+	diags.Append(plan.FieldKeys.ElementsAs(ctx, &fieldKeys, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	fields, err := r.groupFields(ctx, parentGroupID)
+	if err != nil {
+		diags.AddError("failed to read registration fields", util.FormatErrorMessage(err))
+		return diags
+	}
+	groupKeys := make([]string, 0, len(fields))
+	for _, field := range fields {
+		groupKeys = append(groupKeys, field.FieldKey)
+	}
+
+	var unknown []string
+	for _, key := range fieldKeys {
+		if !util.Contains(groupKeys, key) {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		diags.AddAttributeError(
+			path.Root("field_keys"),
+			"Unknown Registration Fields",
+			fmt.Sprintf("The fields %s do not exist in the parent group %s. Available fields: %s",
+				strings.Join(unknown, ", "), parentGroupID, strings.Join(groupKeys, ", ")),
+		)
+		return diags
+	}
+
+	order := make([]cidaas.RegistrationFieldOrder, 0, len(groupKeys))
+	for _, key := range fieldKeys {
+		order = append(order, cidaas.RegistrationFieldOrder{FieldKey: key, Order: int64(len(order) + 1)})
+	}
+	for _, key := range groupKeys {
+		if !util.Contains(fieldKeys, key) {
+			order = append(order, cidaas.RegistrationFieldOrder{FieldKey: key, Order: int64(len(order) + 1)})
+		}
+	}
+
+	if err := r.cidaasClient.RegFields.UpdateOrder(ctx, order); err != nil {
+		tflog.Error(ctx, "failed to update registration field order via API", util.H{
+			"parent_group_id": parentGroupID,
+			"error":           err.Error(),
+		})
+		diags.AddError("failed to update registration field order", util.FormatErrorMessage(err))
+	}
+	return diags
+}
+
+// groupFields returns the registration fields of the parent group sorted by their order.
+func (r *RegFieldOrderResource) groupFields(ctx context.Context, parentGroupID string) ([]cidaas.RegistrationFieldConfig, error) {
+	all, err := r.cidaasClient.RegFields.GetAll(ctx)
+	if err != nil {
+		tflog.Error(ctx, "failed to read registration fields via API", util.H{
+			"parent_group_id": parentGroupID,
+			"error":           err.Error(),
+		})
+		return nil, err
+	}
+
+	fields := make([]cidaas.RegistrationFieldConfig, 0, len(all))
+	for _, field := range all {
+		group := field.ParentGroupID
+		if group == "" {
+			group = defaultRegFieldGroup
+		}
+		if group == parentGroupID {
+			fields = append(fields, field)
+		}
+	}
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i].Order < fields[j].Order })
+	return fields, nil
+}
@@ -0,0 +1,206 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	acctest "github.com/Cidaas/terraform-provider-cidaas/internal/test"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRegistrationFieldOrder_Basic(t *testing.T) {
+	t.Parallel()
+
+	groupKey := acctest.RandString(10)
+	firstKey := acctest.RandString(10)
+	secondKey := acctest.RandString(10)
+	testResourceName := fmt.Sprintf("%s.%s", resources.RESOURCE_REGISTRATION_FIELD_ORDER, groupKey)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testRegFieldOrderConfig(groupKey, firstKey, secondKey, []string{firstKey, secondKey}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "id", groupKey),
+					resource.TestCheckResourceAttr(testResourceName, "field_keys.0", firstKey),
+					resource.TestCheckResourceAttr(testResourceName, "field_keys.1", secondKey),
+				),
+			},
+			{
+				Config: testRegFieldOrderConfig(groupKey, firstKey, secondKey, []string{secondKey, firstKey}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "field_keys.0", secondKey),
+					resource.TestCheckResourceAttr(testResourceName, "field_keys.1", firstKey),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     groupKey,
+			},
+		},
+	})
+}
+
+func TestRegistrationFieldOrder_UnknownField(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					provider "cidaas" {
+						base_url = "%s"
+					}
+					resource "cidaas_registration_field_order" "example" {
+						field_keys = ["%s"]
+					}
+				`, acctest.GetBaseURL(), acctest.RandString(10)),
+				ExpectError: regexp.MustCompile("Unknown Registration Fields"),
+			},
+		},
+	})
+}
+
+// an unmanaged field moved in front of the managed fields is kept in the state, an unmanaged field behind them is not
+func TestRegistrationFieldOrder_ReadUnmanagedField(t *testing.T) {
+	tests := []struct {
+		name     string
+		fields   string
+		expected []string
+	}{
+		{
+			name:     "moved in front",
+			fields:   `[{"fieldKey":"b","order":3},{"fieldKey":"x","order":1},{"fieldKey":"a","order":2}]`,
+			expected: []string{"x", "a", "b"},
+		},
+		{
+			name:     "moved between",
+			fields:   `[{"fieldKey":"a","order":1},{"fieldKey":"x","order":2},{"fieldKey":"b","order":3}]`,
+			expected: []string{"a", "x", "b"},
+		},
+		{
+			name:     "behind",
+			fields:   `[{"fieldKey":"a","order":1},{"fieldKey":"b","order":2},{"fieldKey":"x","order":3}]`,
+			expected: []string{"a", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"success":true,"data":` + tt.fields + `}`))
+			}))
+			defer server.Close()
+
+			r := resources.NewRegFieldOrderResource()
+			client := &cidaas.Client{RegFields: cidaas.NewRegField(cidaas.ClientConfig{BaseURL: server.URL, AccessToken: "test-token"})}
+			configureResp := fwresource.ConfigureResponse{}
+			r.(fwresource.ResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{ProviderData: client}, &configureResp)
+
+			schemaResp := fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+			state := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			diags := state.Set(ctx, resources.RegFieldOrderConfig{
+				ID:            types.StringValue("DEFAULT"),
+				ParentGroupID: types.StringValue("DEFAULT"),
+				FieldKeys:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")}),
+				Timeouts:      nullTimeouts(),
+			})
+			if diags.HasError() {
+				t.Fatalf("Failed to set state: %v", diags)
+			}
+
+			identitySchema := fwresource.IdentitySchemaResponse{}
+			r.(fwresource.ResourceWithIdentity).IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, &identitySchema)
+			resp := fwresource.ReadResponse{
+				State: state,
+				Identity: &tfsdk.ResourceIdentity{
+					Schema: identitySchema.IdentitySchema,
+					Raw:    tftypes.NewValue(identitySchema.IdentitySchema.Type().TerraformType(ctx), nil),
+				},
+			}
+			r.Read(ctx, fwresource.ReadRequest{State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Expected no errors, got %v", resp.Diagnostics)
+			}
+
+			var fieldKeys []string
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("field_keys"), &fieldKeys)...)
+			if !slices.Equal(fieldKeys, tt.expected) {
+				t.Errorf("Expected field_keys %v, got %v", tt.expected, fieldKeys)
+			}
+		})
+	}
+}
+
+func testRegFieldOrderConfig(groupKey, firstKey, secondKey string, order []string) string {
+	return fmt.Sprintf(`
+		provider "cidaas" {
+			base_url = "%s"
+		}
+		resource "cidaas_registration_field" "group" {
+			data_type       = "TEXT"
+			field_key       = "%s"
+			is_group        = true
+			parent_group_id = "DEFAULT"
+			local_texts = [
+				{
+					locale = "en-US"
+					name   = "Sample Group"
+				}
+			]
+		}
+		resource "cidaas_registration_field" "first" {
+			data_type       = "TEXT"
+			field_key       = "%s"
+			parent_group_id = cidaas_registration_field.group.field_key
+			local_texts = [
+				{
+					locale = "en-US"
+					name   = "First Field"
+				}
+			]
+		}
+		resource "cidaas_registration_field" "second" {
+			data_type       = "TEXT"
+			field_key       = "%s"
+			parent_group_id = cidaas_registration_field.group.field_key
+			local_texts = [
+				{
+					locale = "en-US"
+					name   = "Second Field"
+				}
+			]
+		}
+		resource "cidaas_registration_field_order" "%s" {
+			parent_group_id = cidaas_registration_field.group.field_key
+			field_keys      = ["%s"]
+			depends_on      = [cidaas_registration_field.first, cidaas_registration_field.second]
+		}
+	`, acctest.GetBaseURL(), groupKey, firstKey, secondKey, groupKey, strings.Join(order, `", "`))
+}
@@ -1,12 +1,20 @@
 package resources_test
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
 	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
 	acctest "github.com/Cidaas/terraform-provider-cidaas/internal/test"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -249,3 +257,339 @@ func TestRegistrationField_SelectBasic(t *testing.T) {
 		},
 	})
 }
+
+func TestRegistrationField_DeleteReferencedField(t *testing.T) {
+	t.Parallel()
+
+	fieldKey := acctest.RandString(10)
+	consentName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testRegFieldReferenceConfig(fieldKey, consentName, true),
+			},
+			// the consent version still references the field
+			{
+				Config:      testRegFieldReferenceConfig(fieldKey, consentName, false),
+				ExpectError: regexp.MustCompile("Registration Field In Use"),
+			},
+			// restore the dependency so the version is destroyed before the field
+			{
+				Config: testRegFieldReferenceConfig(fieldKey, consentName, true),
+			},
+		},
+	})
+}
+
+func testRegFieldReferenceConfig(fieldKey, consentName string, withField bool) string {
+	// the reference orders the creation of the field before the consent version
+	field, requiredField := "", fmt.Sprintf("%q", fieldKey)
+	if withField {
+		requiredField = "cidaas_registration_field.referenced.field_key"
+		field = fmt.Sprintf(`
+		resource "cidaas_registration_field" "referenced" {
+			data_type = "TEXT"
+			field_key = "%s"
+			local_texts = [
+				{
+					locale = "en-US"
+					name   = "Referenced Field"
+				}
+			]
+		}`, fieldKey)
+	}
+	return fmt.Sprintf(`
+		provider "cidaas" {
+			base_url = "%s"
+		}
+		%s
+		resource "cidaas_consent_group" "sample" {
+			group_name  = "%s"
+			description = "sample description"
+		}
+		resource "cidaas_consent" "sample" {
+			consent_group_id = cidaas_consent_group.sample.id
+			name             = "%s"
+		}
+		resource "cidaas_consent_version" "sample" {
+			version         = 1
+			consent_id      = cidaas_consent.sample.id
+			consent_type    = "SCOPES"
+			scopes          = ["profile"]
+			required_fields = [%s]
+			consent_locales = [
+				{
+					content = "consent in English"
+					locale  = "en"
+				}
+			]
+		}
+	`, acctest.GetBaseURL(), field, consentName, consentName, requiredField)
+}
+
+var (
+	testRegFieldAttributeType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"key":   types.StringType,
+		"value": types.StringType,
+	}}
+	testRegFieldConsentLabelType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"label":      types.StringType,
+		"label_text": types.StringType,
+	}}
+	testRegFieldLocalTextType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"locale":         types.StringType,
+		"name":           types.StringType,
+		"max_length_msg": types.StringType,
+		"min_length_msg": types.StringType,
+		"required_msg":   types.StringType,
+		"attributes":     types.ListType{ElemType: testRegFieldAttributeType},
+		"consent_label":  testRegFieldConsentLabelType,
+	}}
+	testRegFieldDefinitionType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"max_length":        types.Int64Type,
+		"min_length":        types.Int64Type,
+		"min_date":          types.StringType,
+		"max_date":          types.StringType,
+		"initial_date_view": types.StringType,
+		"initial_date":      types.StringType,
+		"regex":             types.StringType,
+	}}
+)
+
+// testRegFieldLocalText returns a local text with the given attribute keys, nil keys result in null attributes.
+func testRegFieldLocalText(locale string, attributeKeys []string, withConsentLabel bool) attr.Value {
+	attributes := types.ListNull(testRegFieldAttributeType)
+	if attributeKeys != nil {
+		values := make([]attr.Value, 0, len(attributeKeys))
+		for _, key := range attributeKeys {
+			values = append(values, types.ObjectValueMust(testRegFieldAttributeType.AttrTypes, map[string]attr.Value{
+				"key":   types.StringValue(key),
+				"value": types.StringValue(key + " value"),
+			}))
+		}
+		attributes = types.ListValueMust(testRegFieldAttributeType, values)
+	}
+	consentLabel := types.ObjectNull(testRegFieldConsentLabelType.AttrTypes)
+	if withConsentLabel {
+		consentLabel = types.ObjectValueMust(testRegFieldConsentLabelType.AttrTypes, map[string]attr.Value{
+			"label":      types.StringValue("I agree"),
+			"label_text": types.StringValue("terms"),
+		})
+	}
+	return types.ObjectValueMust(testRegFieldLocalTextType.AttrTypes, map[string]attr.Value{
+		"locale":         types.StringValue(locale),
+		"name":           types.StringValue("Sample Field"),
+		"max_length_msg": types.StringNull(),
+		"min_length_msg": types.StringNull(),
+		"required_msg":   types.StringNull(),
+		"attributes":     attributes,
+		"consent_label":  consentLabel,
+	})
+}
+
+// testRegFieldDefinition returns a field definition with the given values, all other values are null.
+func testRegFieldDefinition(values map[string]attr.Value) types.Object {
+	fd := map[string]attr.Value{}
+	for name, attrType := range testRegFieldDefinitionType.AttrTypes {
+		if value, ok := values[name]; ok {
+			fd[name] = value
+			continue
+		}
+		if attrType == types.Int64Type {
+			fd[name] = types.Int64Null()
+		} else {
+			fd[name] = types.StringNull()
+		}
+	}
+	return types.ObjectValueMust(testRegFieldDefinitionType.AttrTypes, fd)
+}
+
+func TestRegistrationField_ValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		dataType        string
+		fieldDefinition types.Object
+		localTexts      []attr.Value
+		consentRefs     types.Set
+		expectedPaths   []string
+	}{
+		{
+			name:     "valid text field with regex",
+			dataType: "TEXT",
+			fieldDefinition: testRegFieldDefinition(map[string]attr.Value{
+				"regex": types.StringValue("^.{10,100}$"),
+			}),
+			localTexts: []attr.Value{testRegFieldLocalText("en-US", nil, false)},
+		},
+		{
+			name:     "min_length greater than max_length",
+			dataType: "PASSWORD",
+			fieldDefinition: testRegFieldDefinition(map[string]attr.Value{
+				"min_length": types.Int64Value(20),
+				"max_length": types.Int64Value(10),
+			}),
+			localTexts:    []attr.Value{testRegFieldLocalText("en-US", nil, false)},
+			expectedPaths: []string{"field_definition.min_length"},
+		},
+		{
+			name:     "length on a number field",
+			dataType: "NUMBER",
+			fieldDefinition: testRegFieldDefinition(map[string]attr.Value{
+				"max_length": types.Int64Value(10),
+			}),
+			localTexts:    []attr.Value{testRegFieldLocalText("en-US", nil, false)},
+			expectedPaths: []string{"field_definition.max_length"},
+		},
+		{
+			name:     "regex on a number field",
+			dataType: "NUMBER",
+			fieldDefinition: testRegFieldDefinition(map[string]attr.Value{
+				"regex": types.StringValue("^[0-9]+$"),
+			}),
+			localTexts:    []attr.Value{testRegFieldLocalText("en-US", nil, false)},
+			expectedPaths: []string{"field_definition.regex"},
+		},
+		{
+			name:     "valid date range",
+			dataType: "DATE",
+			fieldDefinition: testRegFieldDefinition(map[string]attr.Value{
+				"min_date":     types.StringValue("2020-01-01T00:00:00Z"),
+				"max_date":     types.StringValue("2030-01-01T00:00:00Z"),
+				"initial_date": types.StringValue("2025-01-01T00:00:00Z"),
+			}),
+			localTexts: []attr.Value{testRegFieldLocalText("en-US", nil, false)},
+		},
+		{
+			name:     "min_date after max_date",
+			dataType: "DATE",
+			fieldDefinition: testRegFieldDefinition(map[string]attr.Value{
+				"min_date": types.StringValue("2030-01-01T00:00:00Z"),
+				"max_date": types.StringValue("2020-01-01T00:00:00Z"),
+			}),
+			localTexts:    []attr.Value{testRegFieldLocalText("en-US", nil, false)},
+			expectedPaths: []string{"field_definition.min_date"},
+		},
+		{
+			name:     "initial_date outside of the date range",
+			dataType: "DATE",
+			fieldDefinition: testRegFieldDefinition(map[string]attr.Value{
+				"min_date":     types.StringValue("2020-01-01T00:00:00Z"),
+				"max_date":     types.StringValue("2030-01-01T00:00:00Z"),
+				"initial_date": types.StringValue("2031-01-01T00:00:00Z"),
+			}),
+			localTexts:    []attr.Value{testRegFieldLocalText("en-US", nil, false)},
+			expectedPaths: []string{"field_definition.initial_date"},
+		},
+		{
+			name:     "valid select field",
+			dataType: "SELECT",
+			localTexts: []attr.Value{
+				testRegFieldLocalText("en-US", []string{"a", "b"}, false),
+				testRegFieldLocalText("de-DE", []string{"b", "a"}, false),
+			},
+		},
+		{
+			name:     "select field with mismatched attribute keys",
+			dataType: "SELECT",
+			localTexts: []attr.Value{
+				testRegFieldLocalText("en-US", []string{"a", "b"}, false),
+				testRegFieldLocalText("de-DE", []string{"a"}, false),
+			},
+			expectedPaths: []string{"local_texts[1].attributes"},
+		},
+		{
+			name:          "radio field without attributes",
+			dataType:      "RADIO",
+			localTexts:    []attr.Value{testRegFieldLocalText("en-US", nil, false)},
+			expectedPaths: []string{"local_texts[0].attributes"},
+		},
+		{
+			name:          "attributes on a text field",
+			dataType:      "TEXT",
+			localTexts:    []attr.Value{testRegFieldLocalText("en-US", []string{"a"}, false)},
+			expectedPaths: []string{"local_texts[0].attributes"},
+		},
+		{
+			name:        "valid consent field",
+			dataType:    "CONSENT",
+			localTexts:  []attr.Value{testRegFieldLocalText("en-US", nil, true)},
+			consentRefs: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("consent-id")}),
+		},
+		{
+			name:          "consent field without consent_label",
+			dataType:      "CONSENT",
+			localTexts:    []attr.Value{testRegFieldLocalText("en-US", nil, false)},
+			expectedPaths: []string{"local_texts[0].consent_label"},
+		},
+		{
+			name:          "consent_refs and consent_label on a text field",
+			dataType:      "TEXT",
+			localTexts:    []attr.Value{testRegFieldLocalText("en-US", nil, true)},
+			consentRefs:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("consent-id")}),
+			expectedPaths: []string{"local_texts[0].consent_label", "consent_refs"},
+		},
+	}
+
+	ctx := context.Background()
+	r := resources.NewRegFieldResource()
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := resources.RegFieldConfig{
+				DataType:        types.StringValue(tc.dataType),
+				FieldKey:        types.StringValue("sample_field"),
+				Scopes:          types.SetNull(types.StringType),
+				ConsentRefs:     types.SetNull(types.StringType),
+				LocalTexts:      types.ListValueMust(testRegFieldLocalTextType, tc.localTexts),
+				FieldDefinition: types.ObjectNull(testRegFieldDefinitionType.AttrTypes),
+				Timeouts:        nullTimeouts(),
+			}
+			if !tc.fieldDefinition.IsNull() {
+				config.FieldDefinition = tc.fieldDefinition
+			}
+			if !tc.consentRefs.IsNull() {
+				config.ConsentRefs = tc.consentRefs
+			}
+
+			state := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			if diags := state.Set(ctx, &config); diags.HasError() {
+				t.Fatalf("failed to build config: %v", diags)
+			}
+
+			resp := fwresource.ValidateConfigResponse{}
+			r.(fwresource.ResourceWithValidateConfig).ValidateConfig(ctx, fwresource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw},
+			}, &resp)
+
+			var paths []string
+			for _, d := range resp.Diagnostics.Errors() {
+				withPath, ok := d.(diag.DiagnosticWithPath)
+				if !ok {
+					t.Errorf("expected an attribute diagnostic, got: %s: %s", d.Summary(), d.Detail())
+					continue
+				}
+				paths = append(paths, withPath.Path().String())
+			}
+			if len(paths) != len(tc.expectedPaths) {
+				t.Fatalf("expected errors at %v, got %v", tc.expectedPaths, paths)
+			}
+			for i := range paths {
+				if paths[i] != tc.expectedPaths[i] {
+					t.Errorf("expected errors at %v, got %v", tc.expectedPaths, paths)
+				}
+			}
+		})
+	}
+}
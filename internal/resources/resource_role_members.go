@@ -0,0 +1,300 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type RoleMembersResource struct {
+	BaseResource
+}
+
+func NewRoleMembersResource() resource.Resource {
+	return &RoleMembersResource{
+		BaseResource: NewBaseResource(
+			BaseResourceConfig{
+				Name:   RESOURCE_ROLE_MEMBERS,
+				Schema: &roleMembersSchema,
+			},
+		),
+	}
+}
+
+type RoleMembersConfig struct {
+	ID       types.String   `tfsdk:"id"`
+	Role     types.String   `tfsdk:"role"`
+	Subs     types.Set      `tfsdk:"subs"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+var roleMembersSchema = schema.Schema{
+	MarkdownDescription: "The `cidaas_role_members` resource manages the complete list of users a role is assigned to." +
+		" It is authoritative, the role is unassigned from every user that is not part of `subs`, including users that were" +
+		" assigned in the admin UI. It must not be used together with the `cidaas_user_role` resource for the same role." +
+		"\n\n Destroying the resource unassigns the role from all users listed in `subs`." +
+		"\n\n Ensure that the below scopes are assigned to the client with the specified `client_id`:" +
+		"\n- cidaas:roles_read" +
+		"\n- cidaas:roles_write" +
+		"\n- cidaas:roles_delete",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the resource. It is the same as the role.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"role": schema.StringAttribute{
+			Required: true,
+			MarkdownDescription: "The role whose members are managed, for example the `role` of a `cidaas_role` resource." +
+				" It is used to import existing members and cannot be updated for an existing state.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				&validators.UniqueIdentifier{},
+			},
+		},
+		"subs": schema.SetAttribute{
+			ElementType:         types.StringType,
+			Required:            true,
+			MarkdownDescription: "The subs of all users the role is assigned to. An empty set unassigns the role from all users.",
+			Validators: []validator.Set{
+				setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+	},
+}
+
+type RoleMembersIdentity struct {
+	Role types.String `tfsdk:"role"`
+}
+
+func (r *RoleMembersResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"role": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The role whose members are managed.",
+			},
+		},
+	}
+}
+
+func (r *RoleMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
+	var plan RoleMembersConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get plan data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcileMembers(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.Role
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, RoleMembersIdentity{Role: plan.Role})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "resource role_members created successfully", util.H{
+		"role": plan.Role.ValueString(),
+	})
+}
+
+func (r *RoleMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
+	var state RoleMembersConfig
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get state data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	members, err := r.cidaasClient.Roles.GetMembers(ctx, state.Role.ValueString())
+	if err != nil {
+		tflog.Error(ctx, "failed to read role members via API", util.H{
+			"role":  state.Role.ValueString(),
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to read role members", util.FormatErrorMessage(err))
+		return
+	}
+
+	state.ID = state.Role
+	state.Subs = util.SetValueOrEmpty(members)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, RoleMembersIdentity{Role: state.Role})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Debug(ctx, "resource role_members read successfully", util.H{
+		"role":          state.Role.ValueString(),
+		"members_count": len(members),
+	})
+}
+
+func (r *RoleMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
+	var plan RoleMembersConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get plan data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcileMembers(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, RoleMembersIdentity{Role: plan.Role})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state after update", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Debug(ctx, "resource role_members updated successfully", util.H{
+		"role": plan.Role.ValueString(),
+	})
+}
+
+func (r *RoleMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
+	var state RoleMembersConfig
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get state data for deletion", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	var subs []string
+	resp.Diagnostics.Append(state.Subs.ElementsAs(ctx, &subs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, sub := range subs {
+		err := r.cidaasClient.Roles.UnassignUser(ctx, cidaas.UserRoleModel{Role: state.Role.ValueString(), Sub: sub})
+		if err != nil {
+			tflog.Error(ctx, "failed to unassign role via API", util.H{
+				"role":  state.Role.ValueString(),
+				"sub":   sub,
+				"error": err.Error(),
+			})
+			resp.Diagnostics.AddError("failed to delete role members", util.FormatErrorMessage(err))
+			return
+		}
+	}
+
+	tflog.Info(ctx, "resource role_members deleted successfully", util.H{
+		"role": state.Role.ValueString(),
+	})
+}
+
+func (r *RoleMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("role"), path.Root("role"), req, resp)
+}
+
+// reconcileMembers assigns the role to the declared subs and unassigns it from all other users.
+func (r *RoleMembersResource) reconcileMembers(ctx context.Context, plan RoleMembersConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+	role := plan.Role.ValueString()
+
+	var desired []string
+	diags.Append(plan.Subs.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	current, err := r.cidaasClient.Roles.GetMembers(ctx, role)
+	if err != nil {
+		tflog.Error(ctx, "failed to read role members via API", util.H{
+			"role":  role,
+			"error": err.Error(),
+		})
+		diags.AddError("failed to read role members", util.FormatErrorMessage(err))
+		return diags
+	}
+
+	for _, sub := range desired {
+		if util.Contains(current, sub) {
+			continue
+		}
+		if _, err := r.cidaasClient.Roles.AssignUser(ctx, cidaas.UserRoleModel{Role: role, Sub: sub}); err != nil {
+			tflog.Error(ctx, "failed to assign role via API", util.H{
+				"role":  role,
+				"sub":   sub,
+				"error": err.Error(),
+			})
+			diags.AddError("failed to assign role to user", util.FormatErrorMessage(err))
+			return diags
+		}
+	}
+
+	for _, sub := range current {
+		if util.Contains(desired, sub) {
+			continue
+		}
+		if err := r.cidaasClient.Roles.UnassignUser(ctx, cidaas.UserRoleModel{Role: role, Sub: sub}); err != nil {
+			tflog.Error(ctx, "failed to unassign role via API", util.H{
+				"role":  role,
+				"sub":   sub,
+				"error": err.Error(),
+			})
+			diags.AddError("failed to unassign role from user", util.FormatErrorMessage(err))
+			return diags
+		}
+		tflog.Info(ctx, "unassigned role from undeclared user", util.H{
+			"role": role,
+			"sub":  sub,
+		})
+	}
+	return diags
+}
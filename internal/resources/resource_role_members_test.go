@@ -0,0 +1,61 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	acctest "github.com/Cidaas/terraform-provider-cidaas/internal/test"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// create, read, import and update test
+func TestAccRoleMembersResource_Basic(t *testing.T) {
+	t.Parallel()
+
+	testResourceID := acctest.RandString(10)
+	role := strings.ToUpper(acctest.RandString(10))
+	testResourceName := fmt.Sprintf("%s.%s", resources.RESOURCE_ROLE_MEMBERS, testResourceID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleMembersResourceConfig(testResourceID, role, fmt.Sprintf("[cidaas_user.%s.sub]", testResourceID)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "role", role),
+					resource.TestCheckResourceAttr(testResourceName, "subs.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(testResourceName, "subs.*", fmt.Sprintf("cidaas_user.%s", testResourceID), "sub"),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     role,
+			},
+			{
+				// an empty set removes all members of the role
+				Config: testAccRoleMembersResourceConfig(testResourceID, role, "[]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "subs.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRoleMembersResourceConfig(resourceID, role, subs string) string {
+	return testAccRoleAndUserConfig(resourceID, role) + fmt.Sprintf(`
+		resource "cidaas_role_members" "%s" {
+			role = cidaas_role.%s.role
+			subs = %s
+		}
+	`, resourceID, resourceID, subs)
+}
@@ -13,6 +13,7 @@ import (
 	acctest "github.com/Cidaas/terraform-provider-cidaas/internal/test"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccRoleResource(t *testing.T) {
@@ -66,6 +67,33 @@ func TestAccRoleResource(t *testing.T) {
 	})
 }
 
+// import with an import block by the resource identity
+func TestAccRoleResource_importByIdentity(t *testing.T) {
+	t.Parallel()
+	role := acctest.RandString(10)
+	testResourceID := acctest.RandString(10)
+	testResourceName := fmt.Sprintf("%s.%s", resources.RESOURCE_ROLE, testResourceID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		CheckDestroy: testAccCheckRoleResourceDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleResourceConfig(role, "Terraform Identity Role", "role imported by identity", testResourceID),
+			},
+			{
+				ResourceName:    testResourceName,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
 func TestAccRoleResource_validateAttrSet(t *testing.T) {
 	t.Parallel()
 	role := acctest.RandString(10)
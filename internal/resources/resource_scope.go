@@ -2,15 +2,22 @@ package resources
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
 
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
 	"github.com/Cidaas/terraform-provider-cidaas/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -29,38 +36,51 @@ func NewScopeResource() resource.Resource {
 	return &ScopeResource{
 		BaseResource: NewBaseResource(
 			BaseResourceConfig{
-				Name:   RESOURCE_SCOPE,
-				Schema: &scopeSchema,
+				Name:    RESOURCE_SCOPE,
+				Schema:  &scopeSchema,
+				Version: 1,
+				StateUpgraders: map[int64]resource.StateUpgrader{
+					// 4.0.0 changed localized_descriptions from a list to a map keyed by locale
+					0: {
+						PriorSchema:   &scopeSchemaV0,
+						StateUpgrader: upgradeScopeStateV0,
+					},
+				},
 			},
 		),
 	}
 }
 
 type ScopeConfig struct {
-	ID                    types.String `tfsdk:"id"`
-	SecurityLevel         types.String `tfsdk:"security_level"`
-	ScopeKey              types.String `tfsdk:"scope_key"`
-	GroupName             types.Set    `tfsdk:"group_name"`
-	RequiredUserConsent   types.Bool   `tfsdk:"required_user_consent"`
-	LocalizedDescription  types.List   `tfsdk:"localized_descriptions"`
-	localizedDescriptions []*LocalDescription
-	ScopeOwner            types.String `tfsdk:"scope_owner"`
+	ID                    types.String   `tfsdk:"id"`
+	SecurityLevel         types.String   `tfsdk:"security_level"`
+	ScopeKey              types.String   `tfsdk:"scope_key"`
+	GroupName             types.Set      `tfsdk:"group_name"`
+	RequiredUserConsent   types.Bool     `tfsdk:"required_user_consent"`
+	LocalizedDescriptions types.Map      `tfsdk:"localized_descriptions"`
+	ScopeOwner            types.String   `tfsdk:"scope_owner"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
 }
 
 type LocalDescription struct {
-	Locale      types.String `tfsdk:"locale"`
 	Title       types.String `tfsdk:"title"`
 	Description types.String `tfsdk:"description"`
 }
 
-func (sc *ScopeConfig) extractLocalizedDescription(ctx context.Context) diag.Diagnostics {
-	var diags diag.Diagnostics
+var localDescriptionType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"title":       types.StringType,
+		"description": types.StringType,
+	},
+}
 
-	if !sc.LocalizedDescription.IsNull() {
-		sc.localizedDescriptions = make([]*LocalDescription, 0, len(sc.LocalizedDescription.Elements()))
-		diags = sc.LocalizedDescription.ElementsAs(ctx, &sc.localizedDescriptions, false)
+func (sc *ScopeConfig) localizedDescriptions(ctx context.Context) (map[string]LocalDescription, diag.Diagnostics) {
+	localizedDescriptions := map[string]LocalDescription{}
+	if sc.LocalizedDescriptions.IsNull() || sc.LocalizedDescriptions.IsUnknown() {
+		return localizedDescriptions, nil
 	}
-	return diags
+	diags := sc.LocalizedDescriptions.ElementsAs(ctx, &localizedDescriptions, false)
+	return localizedDescriptions, diags
 }
 
 var scopeSchema = schema.Schema{
@@ -114,33 +134,31 @@ var scopeSchema = schema.Schema{
 				stringplanmodifier.UseStateForUnknown(),
 			},
 		},
-		"localized_descriptions": schema.ListNestedAttribute{
+		"localized_descriptions": schema.MapNestedAttribute{
 			Optional: true,
+			MarkdownDescription: "The localized title and description of the scope, keyed by locale e.g. `en-US`, `de-DE`." +
+				" A locale removed from the map is deleted from the scope in cidaas.",
+			Validators: []validator.Map{
+				mapvalidator.KeysAre(
+					stringvalidator.OneOf(
+						func() []string {
+							validLocals := make([]string, len(util.Locales))
+							for i, locale := range util.Locales {
+								validLocals[i] = locale.LocaleString
+							}
+							return validLocals
+						}()...),
+				),
+			},
 			NestedObject: schema.NestedAttributeObject{
 				Attributes: map[string]schema.Attribute{
-					"locale": schema.StringAttribute{
-						Optional:            true,
-						Computed:            true,
-						MarkdownDescription: "The locale for the scope, e.g., `en-US`.",
-						Default:             stringdefault.StaticString("en-US"),
-						Validators: []validator.String{
-							stringvalidator.OneOf(
-								func() []string {
-									validLocals := make([]string, len(util.Locales))
-									for i, locale := range util.Locales {
-										validLocals[i] = locale.LocaleString
-									}
-									return validLocals
-								}()...),
-						},
-					},
 					"title": schema.StringAttribute{
 						Required:            true,
-						MarkdownDescription: "The title of the scope in the configured locale.",
+						MarkdownDescription: "The title of the scope in the locale.",
 					},
 					"description": schema.StringAttribute{
 						Optional:            true,
-						MarkdownDescription: "The description of the scope in the configured locale.",
+						MarkdownDescription: "The description of the scope in the locale.",
 						Validators: []validator.String{
 							stringvalidator.LengthBetween(0, 256),
 						},
@@ -151,10 +169,27 @@ var scopeSchema = schema.Schema{
 	},
 }
 
+type ScopeIdentity struct {
+	ScopeKey types.String `tfsdk:"scope_key"`
+}
+
+func (r *ScopeResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"scope_key": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique identifier of the scope.",
+			},
+		},
+	}
+}
+
 func (r *ScopeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
 	var plan ScopeConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	resp.Diagnostics.Append(plan.extractLocalizedDescription(ctx)...)
 	scopePayload, d := generateScopeModel(ctx, plan)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
@@ -175,10 +210,13 @@ func (r *ScopeResource) Create(ctx context.Context, req resource.CreateRequest,
 		"scope_id": response.Data.ID,
 	})
 
+	r.waitForScope(ctx, *scopePayload, &resp.Diagnostics)
+
 	plan.ScopeOwner = util.StringValueOrNull(&response.Data.ScopeOwner)
 	plan.ID = util.StringValueOrNull(&response.Data.ID)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ScopeIdentity{ScopeKey: plan.ScopeKey})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -193,6 +231,9 @@ func (r *ScopeResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *ScopeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
 	var state ScopeConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -220,28 +261,19 @@ func (r *ScopeResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	state.GroupName = util.SetValueOrNull(res.Data.GroupName)
 
 	var diag diag.Diagnostics
-	var objectValues []attr.Value
-	localeDescription := types.ObjectType{
-		AttrTypes: map[string]attr.Type{
-			"locale":      types.StringType,
-			"title":       types.StringType,
-			"description": types.StringType,
-		},
-	}
-
-	for _, sc := range res.Data.LocaleWiseDescription {
-		local := sc.Locale
-		title := sc.Title
-		description := sc.Description
-		objValue := types.ObjectValueMust(localeDescription.AttrTypes, map[string]attr.Value{
-			"locale":      util.StringValueOrNull(&local),
-			"title":       util.StringValueOrNull(&title),
-			"description": util.StringValueOrNull(&description),
-		})
-		objectValues = append(objectValues, objValue)
+	state.LocalizedDescriptions = types.MapNull(localDescriptionType)
+	if len(res.Data.LocaleWiseDescription) > 0 {
+		localizedDescriptions := make(map[string]LocalDescription, len(res.Data.LocaleWiseDescription))
+		for _, sc := range res.Data.LocaleWiseDescription {
+			title := sc.Title
+			description := sc.Description
+			localizedDescriptions[sc.Locale] = LocalDescription{
+				Title:       util.StringValueOrNull(&title),
+				Description: util.StringValueOrNull(&description),
+			}
+		}
+		state.LocalizedDescriptions, diag = types.MapValueFrom(ctx, localDescriptionType, localizedDescriptions)
 	}
-
-	state.LocalizedDescription, diag = types.ListValueFrom(ctx, localeDescription, objectValues)
 	resp.Diagnostics.Append(diag...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to process localized descriptions", util.H{
@@ -252,6 +284,7 @@ func (r *ScopeResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	tflog.Debug(ctx, "successfully processed localized descriptions")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ScopeIdentity{ScopeKey: state.ScopeKey})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -265,12 +298,14 @@ func (r *ScopeResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *ScopeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { //nolint:dupl
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
 	var plan, state ScopeConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	resp.Diagnostics.Append(plan.extractLocalizedDescription(ctx)...)
 	if resp.Diagnostics.HasError() {
-		tflog.Error(ctx, "failed to get plan/state data or extract localized descriptions", util.H{
+		tflog.Error(ctx, "failed to get plan/state data", util.H{
 			"errors": resp.Diagnostics.Errors(),
 		})
 		return
@@ -296,7 +331,15 @@ func (r *ScopeResource) Update(ctx context.Context, req resource.UpdateRequest,
 		"scope_key": plan.ScopeKey.ValueString(),
 	})
 
+	// upsert keeps the locales missing in the payload, the removed ones are deleted explicitly
+	resp.Diagnostics.Append(r.deleteRemovedLocales(ctx, plan, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.waitForScope(ctx, *scopePayload, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ScopeIdentity{ScopeKey: plan.ScopeKey})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state after update", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -310,6 +353,9 @@ func (r *ScopeResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *ScopeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
 	var state ScopeConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -335,7 +381,7 @@ func (r *ScopeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *ScopeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("scope_key"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("scope_key"), path.Root("scope_key"), req, resp)
 }
 
 func generateScopeModel(ctx context.Context, plan ScopeConfig) (*cidaas.ScopeModel, diag.Diagnostics) {
@@ -349,13 +395,197 @@ func generateScopeModel(ctx context.Context, plan ScopeConfig) (*cidaas.ScopeMod
 		return nil, diag
 	}
 
-	for _, ld := range plan.localizedDescriptions {
+	localizedDescriptions, diag := plan.localizedDescriptions(ctx)
+	if diag.HasError() {
+		return nil, diag
+	}
+	// sorted to send a stable payload
+	locales := make([]string, 0, len(localizedDescriptions))
+	for locale := range localizedDescriptions {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	for _, locale := range locales {
+		ld := localizedDescriptions[locale]
 		scope.LocaleWiseDescription = append(scope.LocaleWiseDescription, cidaas.ScopeLocalDescription{
-			Locale:      ld.Locale.ValueString(),
-			Language:    util.GetLanguageForLocale(ld.Locale.ValueString()),
+			Locale:      locale,
+			Language:    util.GetLanguageForLocale(locale),
 			Title:       ld.Title.ValueString(),
 			Description: ld.Description.ValueString(),
 		})
 	}
 	return &scope, nil
 }
+
+// waitForScope re-reads the scope after a write until cidaas returns exactly the written locales.
+func (r *ScopeResource) waitForScope(ctx context.Context, scope cidaas.ScopeModel, diags *diag.Diagnostics) {
+	waitForConsistency(ctx, "scope "+scope.ScopeKey, func(ctx context.Context) (*cidaas.ScopeResponse, error) {
+		return r.cidaasClient.Scopes.Get(ctx, scope.ScopeKey)
+	}, func(res *cidaas.ScopeResponse) bool {
+		if res.Data.RequiredUserConsent != scope.RequiredUserConsent ||
+			len(res.Data.LocaleWiseDescription) != len(scope.LocaleWiseDescription) {
+			return false
+		}
+		for _, written := range scope.LocaleWiseDescription {
+			if !slices.ContainsFunc(res.Data.LocaleWiseDescription, func(read cidaas.ScopeLocalDescription) bool {
+				return strings.EqualFold(read.Locale, written.Locale) && read.Title == written.Title && read.Description == written.Description
+			}) {
+				return false
+			}
+		}
+		return true
+	}, diags)
+}
+
+// deleteRemovedLocales deletes the locales which are available in the state but not in the plan.
+func (r *ScopeResource) deleteRemovedLocales(ctx context.Context, plan, state ScopeConfig) diag.Diagnostics {
+	planned, diags := plan.localizedDescriptions(ctx)
+	current, d := state.localizedDescriptions(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	removed := make([]string, 0, len(current))
+	for locale := range current {
+		if _, ok := planned[locale]; !ok {
+			removed = append(removed, locale)
+		}
+	}
+	sort.Strings(removed)
+
+	for _, locale := range removed {
+		if err := r.cidaasClient.Scopes.DeleteLocale(ctx, plan.ScopeKey.ValueString(), locale); err != nil {
+			tflog.Error(ctx, "failed to delete scope locale via API", util.H{
+				"scope_key": plan.ScopeKey.ValueString(),
+				"locale":    locale,
+				"error":     err.Error(),
+			})
+			diags.AddError("failed to delete scope locale "+locale, util.FormatErrorMessage(err))
+			return diags
+		}
+		tflog.Info(ctx, "successfully deleted scope locale via API", util.H{
+			"scope_key": plan.ScopeKey.ValueString(),
+			"locale":    locale,
+		})
+	}
+	return diags
+}
+
+type scopeConfigV0 struct {
+	ID                   types.String `tfsdk:"id"`
+	SecurityLevel        types.String `tfsdk:"security_level"`
+	ScopeKey             types.String `tfsdk:"scope_key"`
+	GroupName            types.Set    `tfsdk:"group_name"`
+	RequiredUserConsent  types.Bool   `tfsdk:"required_user_consent"`
+	LocalizedDescription types.List   `tfsdk:"localized_descriptions"`
+	ScopeOwner           types.String `tfsdk:"scope_owner"`
+}
+
+type localDescriptionV0 struct {
+	Locale      types.String `tfsdk:"locale"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+}
+
+// scopeSchemaV0 is the schema with localized_descriptions as a list of objects with the locale as attribute.
+var scopeSchemaV0 = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"security_level": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"scope_key": schema.StringAttribute{
+			Required: true,
+		},
+		"group_name": schema.SetAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"required_user_consent": schema.BoolAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"scope_owner": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"localized_descriptions": schema.ListNestedAttribute{
+			Optional: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"locale": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+					},
+					"title": schema.StringAttribute{
+						Required: true,
+					},
+					"description": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+	},
+}
+
+// upgradeScopeStateV0 moves the localized_descriptions list to the map keyed by locale.
+// The scope itself is not changed in cidaas.
+func upgradeScopeStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior scopeConfigV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upgraded := ScopeConfig{
+		ID:                    prior.ID,
+		SecurityLevel:         prior.SecurityLevel,
+		ScopeKey:              prior.ScopeKey,
+		GroupName:             prior.GroupName,
+		RequiredUserConsent:   prior.RequiredUserConsent,
+		ScopeOwner:            prior.ScopeOwner,
+		LocalizedDescriptions: types.MapNull(localDescriptionType),
+		Timeouts:              nullTimeouts(),
+	}
+
+	if !prior.LocalizedDescription.IsNull() {
+		var descriptions []localDescriptionV0
+		resp.Diagnostics.Append(prior.LocalizedDescription.ElementsAs(ctx, &descriptions, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		localizedDescriptions := make(map[string]LocalDescription, len(descriptions))
+		for _, ld := range descriptions {
+			locale := ld.Locale.ValueString()
+			if locale == "" {
+				// default of the locale in the previous schema
+				locale = "en-US"
+			}
+			if _, ok := localizedDescriptions[locale]; ok {
+				resp.Diagnostics.AddWarning("Duplicate Scope Locale",
+					fmt.Sprintf("The locale %s is configured more than once in localized_descriptions of the scope %s, the last one is kept.",
+						locale, prior.ScopeKey.ValueString()))
+			}
+			localizedDescriptions[locale] = LocalDescription{
+				Title:       ld.Title,
+				Description: ld.Description,
+			}
+		}
+		var diags diag.Diagnostics
+		upgraded.LocalizedDescriptions, diags = types.MapValueFrom(ctx, localDescriptionType, localizedDescriptions)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &upgraded)...)
+	tflog.Info(ctx, "upgraded scope state to version 1", util.H{
+		"scope_key": prior.ScopeKey.ValueString(),
+	})
+}
@@ -6,9 +6,11 @@ import (
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
 	"github.com/Cidaas/terraform-provider-cidaas/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -33,11 +35,12 @@ func NewScopeGroupResource() resource.Resource {
 }
 
 type ScopeGroupConfig struct {
-	ID          types.String `tfsdk:"id"`
-	GroupName   types.String `tfsdk:"group_name"`
-	Description types.String `tfsdk:"description"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
+	ID          types.String   `tfsdk:"id"`
+	GroupName   types.String   `tfsdk:"group_name"`
+	Description types.String   `tfsdk:"description"`
+	CreatedAt   types.String   `tfsdk:"created_at"`
+	UpdatedAt   types.String   `tfsdk:"updated_at"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 var scopeGroupSchema = schema.Schema{
@@ -86,7 +89,25 @@ var scopeGroupSchema = schema.Schema{
 	},
 }
 
+type ScopeGroupIdentity struct {
+	GroupName types.String `tfsdk:"group_name"`
+}
+
+func (r *ScopeGroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"group_name": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique identifier of the scope group.",
+			},
+		},
+	}
+}
+
 func (r *ScopeGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
 	var plan ScopeGroupConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -117,6 +138,7 @@ func (r *ScopeGroupResource) Create(ctx context.Context, req resource.CreateRequ
 	plan.UpdatedAt = util.StringValueOrNull(&res.Data.UpdatedTime)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ScopeGroupIdentity{GroupName: plan.GroupName})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -131,6 +153,9 @@ func (r *ScopeGroupResource) Create(ctx context.Context, req resource.CreateRequ
 }
 
 func (r *ScopeGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { //nolint:dupl
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
 	var state ScopeGroupConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -157,6 +182,7 @@ func (r *ScopeGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 	state.Description = util.StringValueOrNull(&res.Data.Description)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ScopeGroupIdentity{GroupName: state.GroupName})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -171,6 +197,9 @@ func (r *ScopeGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *ScopeGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
 	var plan, state ScopeGroupConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -199,6 +228,7 @@ func (r *ScopeGroupResource) Update(ctx context.Context, req resource.UpdateRequ
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ScopeGroupIdentity{GroupName: plan.GroupName})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state after update", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -212,6 +242,9 @@ func (r *ScopeGroupResource) Update(ctx context.Context, req resource.UpdateRequ
 }
 
 func (r *ScopeGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
 	var state ScopeGroupConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -236,5 +269,5 @@ func (r *ScopeGroupResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *ScopeGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("group_name"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("group_name"), path.Root("group_name"), req, resp)
 }
@@ -3,8 +3,8 @@ package resources_test
 import (
 	"context"
 	"fmt"
-	"os"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"testing"
@@ -13,6 +13,12 @@ import (
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
 	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
 	acctest "github.com/Cidaas/terraform-provider-cidaas/internal/test"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -27,10 +33,9 @@ const (
 
 var (
 	defaultScopeGroupName = []string{"developer"}
-	localizedDescriptions = []map[string]string{
-		{
+	localizedDescriptions = map[string]map[string]string{
+		locale: {
 			"title":       title,
-			"locale":      locale,
 			"description": scopeDescription,
 		},
 	}
@@ -42,10 +47,9 @@ func TestAccScopeResource_Basic(t *testing.T) {
 
 	updatedScopeDescription := "Updated description of the scope in German"
 	updatedRequiredUserConsent := true
-	localizedDesc := []map[string]string{
-		{
-			"title":  title,
-			"locale": locale,
+	localizedDesc := map[string]map[string]string{
+		locale: {
+			"title": title,
 			// description is updated to validate update operation
 			"description": updatedScopeDescription,
 		},
@@ -88,7 +92,28 @@ func TestAccScopeResource_Basic(t *testing.T) {
 				),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(testResourceName, "required_user_consent", strconv.FormatBool(updatedRequiredUserConsent)),
-					resource.TestCheckResourceAttr(testResourceName, "localized_descriptions.0.description", updatedScopeDescription),
+					resource.TestCheckResourceAttr(testResourceName, "localized_descriptions.de-DE.description", updatedScopeDescription),
+				),
+			},
+			{
+				// a new locale is added and the previous one is removed in cidaas
+				Config: testAccScopeResourceConfig(
+					scopeSecurityLevel,
+					scopeKey,
+					testResourceID,
+					updatedRequiredUserConsent,
+					defaultScopeGroupName,
+					map[string]map[string]string{
+						"en-US": {
+							"title":       "scope title in English",
+							"description": "The description of the scope in English",
+						},
+					},
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "localized_descriptions.%", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "localized_descriptions.en-US.title", "scope title in English"),
+					resource.TestCheckNoResourceAttr(testResourceName, "localized_descriptions.de-DE.title"),
 				),
 			},
 		},
@@ -99,13 +124,22 @@ func testAccScopeResourceConfig(
 	securityLevel, scopeKey, resourceID string,
 	requiredUserConsent bool,
 	groupName []string,
-	localizedDescriptions []map[string]string,
+	localizedDescriptions map[string]map[string]string,
 ) string {
 	groupNameString := "[]"
 	if len(groupName) > 0 {
 		groupNameString = `["` + strings.Join(groupName, `", "`) + `"]`
 	}
 
+	locales := make([]string, 0, len(localizedDescriptions))
+	for locale, ld := range localizedDescriptions {
+		locales = append(locales, fmt.Sprintf(`"%s" = {
+					title = "%s"
+					description = "%s"
+				}`, locale, ld["title"], ld["description"]))
+	}
+	sort.Strings(locales)
+
 	return fmt.Sprintf(`
 		provider "cidaas" {
 			base_url = "%s"
@@ -115,13 +149,9 @@ func testAccScopeResourceConfig(
 			scope_key = "`+scopeKey+`"
 			required_user_consent = "`+strconv.FormatBool(requiredUserConsent)+`"
 			group_name = `+groupNameString+`
-			localized_descriptions =[
-				{
-					title = "`+localizedDescriptions[0]["title"]+`"
-					locale = "`+localizedDescriptions[0]["locale"]+`"
-					description = "`+localizedDescriptions[0]["description"]+`"
-				}
-			]
+			localized_descriptions = {
+				`+strings.Join(locales, "\n\t\t\t\t")+`
+			}
 		}
 	`, acctest.GetBaseURL(), resourceID)
 }
@@ -135,7 +165,7 @@ func testCheckScopeDestroyed(resourceName string) resource.TestCheckFunc {
 
 		scope := cidaas.Scope{
 			ClientConfig: cidaas.ClientConfig{
-				BaseURL:     os.Getenv("BASE_URL"),
+				BaseURL:     acctest.GetBaseURL(),
 				AccessToken: acctest.TestToken,
 			},
 		}
@@ -264,13 +294,12 @@ func TestAccScopeResource_DefaultRequiredConsent(t *testing.T) {
 					security_level = "PUBLIC"
 					scope_key = "`+scopeKey+`"
 					group_name = ["developer"]
-					localized_descriptions =[
-						{
+					localized_descriptions = {
+						"`+locale+`" = {
 							title = "`+title+`"
-							locale = "`+locale+`"
 							description = "`+scopeDescription+`"
 						}
-					]
+					}
 				}
 				`, acctest.GetBaseURL(), scopeKey),
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -282,7 +311,7 @@ func TestAccScopeResource_DefaultRequiredConsent(t *testing.T) {
 	})
 }
 
-// localized_descriptions[i].title is required
+// localized_descriptions[locale].title is required
 func TestAccScopeResource_TitleRequired(t *testing.T) {
 	t.Parallel()
 
@@ -301,12 +330,11 @@ func TestAccScopeResource_TitleRequired(t *testing.T) {
 					security_level = "PUBLIC"
 					scope_key = "`+scopeKey+`"
 					group_name = ["developer"]
-					localized_descriptions =[
-						{
-							locale = "`+locale+`"
+					localized_descriptions = {
+						"`+locale+`" = {
 							description = "`+scopeDescription+`"
 						}
-					]
+					}
 				}
 				`, acctest.GetBaseURL(), scopeKey),
 				ExpectError: regexp.MustCompile(`attribute "title" is required`),
@@ -335,17 +363,96 @@ func TestAccScopeResource_InvalidLocale(t *testing.T) {
 					security_level = "PUBLIC"
 					scope_key = "`+scopeKey+`"
 					group_name = ["developer"]
-					localized_descriptions =[
-						{
+					localized_descriptions = {
+						"`+invalidLocale+`" = {
 							title = "`+title+`"
-							locale = "`+invalidLocale+`"
 							description = "`+scopeDescription+`"
 						}
-					]
+					}
 				}
 				`, acctest.GetBaseURL(), scopeKey),
-				ExpectError: regexp.MustCompile(`locale value must be one of`), // TODO:full error string comparison
+				ExpectError: regexp.MustCompile(`value must be one of`), // TODO:full error string comparison
 			},
 		},
 	})
 }
+
+func TestScope_UpgradeStateV0(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := resources.NewScopeResource()
+	upgrader := r.(fwresource.ResourceWithUpgradeState).UpgradeState(ctx)[0]
+
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	localDescriptionV0 := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"locale":      types.StringType,
+			"title":       types.StringType,
+			"description": types.StringType,
+		},
+	}
+	priorState := tfsdk.State{
+		Schema: *upgrader.PriorSchema,
+		Raw:    tftypes.NewValue(upgrader.PriorSchema.Type().TerraformType(ctx), nil),
+	}
+	for name, value := range map[string]attr.Value{
+		"id":                    types.StringValue("scope-id"),
+		"scope_key":             types.StringValue("sample-scope"),
+		"security_level":        types.StringValue("PUBLIC"),
+		"required_user_consent": types.BoolValue(false),
+		"group_name":            types.SetValueMust(types.StringType, []attr.Value{types.StringValue("developer")}),
+		"localized_descriptions": types.ListValueMust(localDescriptionV0, []attr.Value{
+			types.ObjectValueMust(localDescriptionV0.AttrTypes, map[string]attr.Value{
+				"locale":      types.StringValue("de-DE"),
+				"title":       types.StringValue("Titel"),
+				"description": types.StringValue("Beschreibung"),
+			}),
+			types.ObjectValueMust(localDescriptionV0.AttrTypes, map[string]attr.Value{
+				"locale":      types.StringNull(),
+				"title":       types.StringValue("Title"),
+				"description": types.StringNull(),
+			}),
+		}),
+	} {
+		if diags := priorState.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			t.Fatalf("failed to build prior state: %v", diags)
+		}
+	}
+
+	resp := fwresource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	upgrader.StateUpgrader(ctx, fwresource.UpgradeStateRequest{State: &priorState}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("failed to upgrade state: %v", resp.Diagnostics)
+	}
+
+	var upgraded resources.ScopeConfig
+	if diags := resp.State.Get(ctx, &upgraded); diags.HasError() {
+		t.Fatalf("failed to read upgraded state: %v", diags)
+	}
+	if upgraded.ID.ValueString() != "scope-id" || upgraded.ScopeKey.ValueString() != "sample-scope" {
+		t.Errorf("expected id and scope_key to be kept, got %s and %s", upgraded.ID, upgraded.ScopeKey)
+	}
+
+	localized := map[string]resources.LocalDescription{}
+	if diags := upgraded.LocalizedDescriptions.ElementsAs(ctx, &localized, false); diags.HasError() {
+		t.Fatalf("failed to read localized_descriptions: %v", diags)
+	}
+	if len(localized) != 2 {
+		t.Fatalf("expected 2 locales, got %d", len(localized))
+	}
+	if localized["de-DE"].Title.ValueString() != "Titel" || localized["de-DE"].Description.ValueString() != "Beschreibung" {
+		t.Errorf("unexpected de-DE description: %+v", localized["de-DE"])
+	}
+	// a missing locale falls back to the previous default en-US
+	if localized["en-US"].Title.ValueString() != "Title" || !localized["en-US"].Description.IsNull() {
+		t.Errorf("unexpected en-US description: %+v", localized["en-US"])
+	}
+}
@@ -8,11 +8,13 @@ import (
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
 	"github.com/Cidaas/terraform-provider-cidaas/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
@@ -66,6 +68,7 @@ type SocialProviderConfig struct {
 
 	claims         *Claims
 	userInfoFields []*UserInfoFields
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
 type Claims struct {
@@ -284,7 +287,34 @@ var socialProviderSchema = schema.Schema{
 	},
 }
 
+type SocialProviderIdentity struct {
+	ProviderName types.String `tfsdk:"provider_name"`
+	ID           types.String `tfsdk:"id"`
+}
+
+func (i SocialProviderIdentity) importID() string {
+	return i.ProviderName.ValueString() + ":" + i.ID.ValueString()
+}
+
+func (r *SocialProvider) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"provider_name": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The name of the social provider, e.g. `google`.",
+			},
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique identifier of the social provider.",
+			},
+		},
+	}
+}
+
 func (r *SocialProvider) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
 	var plan SocialProviderConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(plan.extract(ctx)...)
@@ -319,6 +349,7 @@ func (r *SocialProvider) Create(ctx context.Context, req resource.CreateRequest,
 	resp.Diagnostics.Append(setClaimsInfo(&plan, res.Data.Claims)...)
 	resp.Diagnostics.Append(setUserInfoFields(&plan, res.Data.UserInfoFields)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, SocialProviderIdentity{ProviderName: plan.ProviderName, ID: plan.ID})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set claims, user info fields, or state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -333,6 +364,9 @@ func (r *SocialProvider) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *SocialProvider) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
 	var state SocialProviderConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -389,6 +423,7 @@ func (r *SocialProvider) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, SocialProviderIdentity{ProviderName: state.ProviderName, ID: state.ID})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -403,6 +438,9 @@ func (r *SocialProvider) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (r *SocialProvider) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
 	var plan, state SocialProviderConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -441,6 +479,7 @@ func (r *SocialProvider) Update(ctx context.Context, req resource.UpdateRequest,
 	resp.Diagnostics.Append(setClaimsInfo(&plan, res.Data.Claims)...)
 	resp.Diagnostics.Append(setUserInfoFields(&plan, res.Data.UserInfoFields)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, SocialProviderIdentity{ProviderName: plan.ProviderName, ID: plan.ID})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set claims, user info fields, or state after update", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -455,6 +494,9 @@ func (r *SocialProvider) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *SocialProvider) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
 	var state SocialProviderConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -482,7 +524,10 @@ func (r *SocialProvider) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *SocialProvider) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id := req.ID
+	id := importID[SocialProviderIdentity](ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	parts := strings.Split(id, ":")
 	if len(parts) != 2 {
 		resp.Diagnostics.AddError(
@@ -493,11 +538,8 @@ func (r *SocialProvider) ImportState(ctx context.Context, req resource.ImportSta
 	}
 	providerName := parts[0]
 	providerID := parts[1]
-	if !util.Contains(allowedProviders, providerName) {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Invalid provider_name provided in import identifier. Valid provider_names %+v, got: %s", allowedProviders, providerName),
-		)
+	if _, err := SocialProviderImportID(providerName, providerID); err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
 		return
 	}
 	resp.State.SetAttribute(ctx, path.Root("provider_name"), providerName)
@@ -773,3 +815,14 @@ var userInfoFieldsType = types.ObjectType{
 		"is_system_field": types.BoolType,
 	},
 }
+
+// SocialProviderImportID returns the import identifier of a social provider in the format provider_name:provider_id.
+func SocialProviderImportID(providerName, providerID string) (string, error) {
+	if !util.Contains(allowedProviders, providerName) {
+		return "", fmt.Errorf("Invalid provider_name provided in import identifier. Valid provider_names %+v, got: %s", allowedProviders, providerName)
+	}
+	if providerID == "" || strings.Contains(providerID, ":") {
+		return "", fmt.Errorf("Invalid provider_id provided in import identifier. The provider_id must not be empty or contain ':', got: %s", providerID)
+	}
+	return providerName + ":" + providerID, nil
+}
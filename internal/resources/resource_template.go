@@ -9,10 +9,12 @@ import (
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
 	"github.com/Cidaas/terraform-provider-cidaas/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -28,20 +30,21 @@ var (
 )
 
 type TemplateConfig struct {
-	ID               types.String `tfsdk:"id"`
-	Locale           types.String `tfsdk:"locale"`
-	TemplateKey      types.String `tfsdk:"template_key"`
-	TemplateType     types.String `tfsdk:"template_type"`
-	Content          types.String `tfsdk:"content"`
-	Subject          types.String `tfsdk:"subject"`
-	TemplateOwner    types.String `tfsdk:"template_owner"`
-	UsageType        types.String `tfsdk:"usage_type"`
-	ProcessingType   types.String `tfsdk:"processing_type"`
-	VerificationType types.String `tfsdk:"verification_type"`
-	Language         types.String `tfsdk:"language"`
-	GroupID          types.String `tfsdk:"group_id"`
-	IsSystemTemplate types.Bool   `tfsdk:"is_system_template"`
-	Enabled          types.Bool   `tfsdk:"enabled"`
+	ID               types.String   `tfsdk:"id"`
+	Locale           types.String   `tfsdk:"locale"`
+	TemplateKey      types.String   `tfsdk:"template_key"`
+	TemplateType     types.String   `tfsdk:"template_type"`
+	Content          types.String   `tfsdk:"content"`
+	Subject          types.String   `tfsdk:"subject"`
+	TemplateOwner    types.String   `tfsdk:"template_owner"`
+	UsageType        types.String   `tfsdk:"usage_type"`
+	ProcessingType   types.String   `tfsdk:"processing_type"`
+	VerificationType types.String   `tfsdk:"verification_type"`
+	Language         types.String   `tfsdk:"language"`
+	GroupID          types.String   `tfsdk:"group_id"`
+	IsSystemTemplate types.Bool     `tfsdk:"is_system_template"`
+	Enabled          types.Bool     `tfsdk:"enabled"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 type TemplateResource struct {
@@ -62,6 +65,9 @@ func NewTemplateResource() resource.Resource {
 var templateSchema = schema.Schema{
 	MarkdownDescription: "The Template resource in the provider is used to define and manage templates within the Cidaas system." +
 		" Templates are used for emails, SMS, IVR, and push notifications." +
+		"\n\n For system templates, the placeholders used in `content` and `subject` are validated during plan against the supported tags" +
+		" of the `template_key`, `template_type` and `processing_type`. A plan fails when a required tag is missing or an unsupported tag is used." +
+		" The supported tags can be looked up with the `cidaas_system_template_option` data source." +
 		"\n\n Ensure that the below scopes are assigned to the client with the specified `client_id`:" +
 		"\n- cidaas:templates_read" +
 		"\n- cidaas:templates_write" +
@@ -206,7 +212,57 @@ func (r *TemplateResource) ValidateConfig(ctx context.Context, req resource.Vali
 	}
 }
 
+func (r *TemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// the master list can only be fetched once the provider is configured and is irrelevant on destroy
+	if req.Plan.Raw.IsNull() || r.cidaasClient == nil {
+		return
+	}
+
+	var config TemplateConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || !config.IsSystemTemplate.ValueBool() {
+		return
+	}
+	if config.TemplateKey.IsUnknown() || config.TemplateType.IsUnknown() || config.ProcessingType.IsUnknown() ||
+		config.GroupID.IsUnknown() || config.Content.IsUnknown() || config.Subject.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(r.validateTemplatePlaceholders(ctx, config)...)
+}
+
+type TemplateIdentity struct {
+	TemplateKey  types.String `tfsdk:"template_key"`
+	TemplateType types.String `tfsdk:"template_type"`
+	Locale       types.String `tfsdk:"locale"`
+}
+
+func (i TemplateIdentity) importID() string {
+	return i.TemplateKey.ValueString() + ":" + i.TemplateType.ValueString() + ":" + i.Locale.ValueString()
+}
+
+func (r *TemplateResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"template_key": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The key of the template, e.g. `VERIFY_USER`.",
+			},
+			"template_type": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The type of the template, e.g. `EMAIL`.",
+			},
+			"locale": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The locale of the template in lowercase, e.g. `en-us`.",
+			},
+		},
+	}
+}
+
 func (r *TemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
 	var plan, config TemplateConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -231,6 +287,8 @@ func (r *TemplateResource) Create(ctx context.Context, req resource.CreateReques
 		"template_id": res.Data.ID,
 	})
 
+	r.waitForTemplate(ctx, *template, res.Data, plan.IsSystemTemplate.ValueBool(), &resp.Diagnostics)
+
 	plan.ID = util.StringValueOrNull(&res.Data.ID)
 	plan.TemplateOwner = util.StringValueOrNull(&res.Data.TemplateOwner)
 	plan.Language = util.StringValueOrNull(&res.Data.Language)
@@ -238,6 +296,7 @@ func (r *TemplateResource) Create(ctx context.Context, req resource.CreateReques
 	plan.GroupID = util.StringValueOrNull(&res.Data.GroupID)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TemplateIdentity{TemplateKey: plan.TemplateKey, TemplateType: plan.TemplateType, Locale: plan.Locale})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -251,6 +310,9 @@ func (r *TemplateResource) Create(ctx context.Context, req resource.CreateReques
 }
 
 func (r *TemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
 	var state TemplateConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	cidaasClient = r.cidaasClient // global variable cidaasClient is assigned here
@@ -305,6 +367,7 @@ func (r *TemplateResource) Read(ctx context.Context, req resource.ReadRequest, r
 	state.Subject = util.StringValueOrNull(&res.Data.Subject)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TemplateIdentity{TemplateKey: state.TemplateKey, TemplateType: state.TemplateType, Locale: state.Locale})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -318,6 +381,9 @@ func (r *TemplateResource) Read(ctx context.Context, req resource.ReadRequest, r
 }
 
 func (r *TemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { //nolint:dupl
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
 	var plan, state TemplateConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -344,6 +410,7 @@ func (r *TemplateResource) Update(ctx context.Context, req resource.UpdateReques
 	tflog.Info(ctx, "successfully updated template via API", util.H{
 		"template_id": state.ID.ValueString(),
 	})
+	r.waitForTemplate(ctx, *template, res.Data, plan.IsSystemTemplate.ValueBool(), &resp.Diagnostics)
 
 	plan.TemplateOwner = util.StringValueOrNull(&res.Data.TemplateOwner)
 	plan.UsageType = util.StringValueOrNull(&res.Data.UsageType)
@@ -351,6 +418,7 @@ func (r *TemplateResource) Update(ctx context.Context, req resource.UpdateReques
 	plan.GroupID = util.StringValueOrNull(&res.Data.GroupID)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TemplateIdentity{TemplateKey: plan.TemplateKey, TemplateType: plan.TemplateType, Locale: plan.Locale})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state after update", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -364,6 +432,9 @@ func (r *TemplateResource) Update(ctx context.Context, req resource.UpdateReques
 }
 
 func (r *TemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
 	var state TemplateConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -397,7 +468,10 @@ func (r *TemplateResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *TemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id := req.ID
+	id := importID[TemplateIdentity](ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	parts := strings.Split(id, ":")
 	if len(parts) != 3 {
 		resp.Diagnostics.AddError(
@@ -411,40 +485,64 @@ func (r *TemplateResource) ImportState(ctx context.Context, req resource.ImportS
 	templateType := parts[1]
 	locale := parts[2]
 
-	if templateKey != strings.ToUpper(templateKey) {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected template_key to be in uppercase. Got: %s", templateKey),
-		)
+	if err := validateTemplateImportID(templateKey, templateType, locale); err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
 		return
 	}
 
-	if !util.Contains(allowedTemplateTypes, templateType) {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Invalid template_type provided in import identifier. Valid template_types %+v, got: %s", allowedTemplateTypes, templateType),
-		)
-		return
+	message := "System Templates cannot be imported using Terraform's import functionality." +
+		"To import an existing system template, please create a system template configuration and run the \033[1mterraform apply\033[0m command."
+	resp.Diagnostics.AddWarning("System Template Import Alert", message)
+	resp.State.SetAttribute(ctx, path.Root("template_key"), templateKey)
+	resp.State.SetAttribute(ctx, path.Root("template_type"), templateType)
+	resp.State.SetAttribute(ctx, path.Root("locale"), locale)
+}
+
+// TemplateImportID returns the import identifier of a template in the format template_key:template_type:locale.
+func TemplateImportID(templateKey, templateType, locale string) (string, error) {
+	if err := validateTemplateImportID(templateKey, templateType, locale); err != nil {
+		return "", err
 	}
+	return strings.Join([]string{templateKey, templateType, locale}, ":"), nil
+}
 
+func validateTemplateImportID(templateKey, templateType, locale string) error {
+	if templateKey != strings.ToUpper(templateKey) {
+		return fmt.Errorf("Expected template_key to be in uppercase. Got: %s", templateKey)
+	}
+	if !util.Contains(allowedTemplateTypes, templateType) {
+		return fmt.Errorf("Invalid template_type provided in import identifier. Valid template_types %+v, got: %s", allowedTemplateTypes, templateType)
+	}
 	validLocals := make([]string, len(util.Locales))
 	for i, l := range util.Locales {
 		validLocals[i] = strings.ToLower(l.LocaleString)
 	}
 	if !util.Contains(validLocals, locale) {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Invalid locale provided in import identifier. Valid locales %+v, got: %s", validLocals, locale),
-		)
-		return
+		return fmt.Errorf("Invalid locale provided in import identifier. Valid locales %+v, got: %s", validLocals, locale)
 	}
+	return nil
+}
 
-	message := "System Templates cannot be imported using Terraform's import functionality." +
-		"To import an existing system template, please create a system template configuration and run the \033[1mterraform apply\033[0m command."
-	resp.Diagnostics.AddWarning("System Template Import Alert", message)
-	resp.State.SetAttribute(ctx, path.Root("template_key"), templateKey)
-	resp.State.SetAttribute(ctx, path.Root("template_type"), templateType)
-	resp.State.SetAttribute(ctx, path.Root("locale"), locale)
+// waitForTemplate re-reads the template after a write until cidaas returns the written content and subject.
+// The usage type and group of a system template are taken from the response of the write as they can be computed.
+func (r *TemplateResource) waitForTemplate(ctx context.Context, template, written cidaas.TemplateModel, isSystemTemplate bool, diags *diag.Diagnostics) {
+	query := cidaas.TemplateModel{
+		Locale:       template.Locale,
+		TemplateKey:  template.TemplateKey,
+		TemplateType: template.TemplateType,
+	}
+	if isSystemTemplate {
+		query.ProcessingType = template.ProcessingType
+		query.UsageType = written.UsageType
+		query.VerificationType = template.VerificationType
+		query.GroupID = written.GroupID
+	}
+	name := fmt.Sprintf("template %s:%s:%s", template.TemplateKey, template.TemplateType, template.Locale)
+	waitForConsistency(ctx, name, func(ctx context.Context) (*cidaas.TemplateResponse, error) {
+		return r.cidaasClient.Templates.Get(ctx, query, isSystemTemplate)
+	}, func(res *cidaas.TemplateResponse) bool {
+		return res.Data.Content == template.Content && res.Data.Subject == template.Subject
+	}, diags)
 }
 
 func prepareTemplateModel(plan TemplateConfig) *cidaas.TemplateModel {
@@ -656,3 +754,102 @@ func validateSystemTemplateConfig(ctx context.Context, config TemplateConfig) di
 	}
 	return diags
 }
+
+// validateTemplatePlaceholders checks the placeholders used in content and subject of a system template
+// against the tags supported for its template_key, template_type and processing_type.
+func (r *TemplateResource) validateTemplatePlaceholders(ctx context.Context, config TemplateConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+	masterList, err := r.cidaasClient.Templates.GetMasterList(ctx, config.GroupID.ValueString())
+	if err != nil {
+		tflog.Error(ctx, "failed to fetch template master list", util.H{
+			"group_id": config.GroupID.ValueString(),
+			"error":    err.Error(),
+		})
+		diags.AddError(
+			fmt.Sprintf("Failed to read the settings list for the provided group_id %s. Please check whether the provided group_id is valid.", config.GroupID.ValueString()),
+			util.FormatErrorMessage(err),
+		)
+		return diags
+	}
+
+	// an invalid combination of template_key and template_type is reported by validateSystemTemplateConfig
+	isSupported := false
+	for _, ml := range masterList.Data {
+		if ml.TemplateKey != config.TemplateKey.ValueString() {
+			continue
+		}
+		for _, tt := range ml.TemplateTypes {
+			if tt.TemplateType == config.TemplateType.ValueString() {
+				isSupported = true
+			}
+		}
+	}
+	if !isSupported {
+		return diags
+	}
+
+	processingType := cidaas.GENERAL
+	if !config.ProcessingType.IsNull() {
+		processingType = config.ProcessingType.ValueString()
+	}
+	th := cidaas.TemplateTagHandler{
+		TemplateData: cidaas.TemplateData{
+			TemplateType:   config.TemplateType.ValueString(),
+			ProcessingType: processingType,
+		},
+	}
+	th.AddSupportedTags()
+	required, optional := th.GetTemplateTags(config.TemplateKey.ValueString()).Names()
+	// no supported tags are known for the combination, the placeholders can not be validated
+	if len(required) == 0 && len(optional) == 0 {
+		return diags
+	}
+	supported := append(append([]string{}, required...), optional...)
+
+	placeholders := map[string][]string{
+		"content": cidaas.ParseTemplatePlaceholders(config.Content.ValueString()),
+		"subject": cidaas.ParseTemplatePlaceholders(config.Subject.ValueString()),
+	}
+
+	var missing []string
+	for _, tag := range required {
+		if !util.Contains(placeholders["content"], tag) && !util.Contains(placeholders["subject"], tag) {
+			missing = append(missing, tag)
+		}
+	}
+	if len(missing) > 0 {
+		diags.AddAttributeError(
+			path.Root("content"),
+			"Missing Required Template Tags",
+			fmt.Sprintf("The system template with template_key %s, template_type %s and processing_type %s must contain the required tags %s, missing: %s",
+				config.TemplateKey.ValueString(), config.TemplateType.ValueString(), processingType, formatTemplateTags(required), formatTemplateTags(missing)),
+		)
+	}
+
+	for _, attribute := range []string{"content", "subject"} {
+		var unknown []string
+		for _, tag := range placeholders[attribute] {
+			if !util.Contains(supported, tag) {
+				unknown = append(unknown, tag)
+			}
+		}
+		if len(unknown) > 0 {
+			diags.AddAttributeError(
+				path.Root(attribute),
+				"Unsupported Template Tags",
+				fmt.Sprintf("The attribute %s contains tags that are not supported for the system template with template_key %s, template_type %s and processing_type %s: %s."+
+					" Supported tags are %s",
+					attribute, config.TemplateKey.ValueString(), config.TemplateType.ValueString(), processingType, formatTemplateTags(unknown), formatTemplateTags(supported)),
+			)
+		}
+	}
+	return diags
+}
+
+func formatTemplateTags(tags []string) string {
+	formatted := make([]string, len(tags))
+	for i, tag := range tags {
+		formatted[i] = "{{" + tag + "}}"
+	}
+	return strings.Join(formatted, ", ")
+}
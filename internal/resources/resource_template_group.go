@@ -5,11 +5,13 @@ import (
 
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -47,6 +49,7 @@ type TemplateGroupConfig struct {
 	smsSenderConfig   *SMSSenderConfig
 	ivrSenderConfig   *IVRSenderConfig
 	pushSenderConfig  *IVRSenderConfig
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
 type EmailSenderConfig struct {
@@ -196,7 +199,25 @@ func (tg *TemplateGroupConfig) ExtractConfigs(ctx context.Context) diag.Diagnost
 	return diags
 }
 
+type TemplateGroupIdentity struct {
+	GroupID types.String `tfsdk:"group_id"`
+}
+
+func (r *TemplateGroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"group_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique identifier of the template group.",
+			},
+		},
+	}
+}
+
 func (r *TemplateGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
 	var plan TemplateGroupConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(plan.ExtractConfigs(ctx)...)
@@ -233,6 +254,7 @@ func (r *TemplateGroupResource) Create(ctx context.Context, req resource.CreateR
 
 	updatedPlan := updateState(&plan, *res)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedPlan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TemplateGroupIdentity{GroupID: updatedPlan.GroupID})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -246,6 +268,9 @@ func (r *TemplateGroupResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *TemplateGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
 	var state TemplateGroupConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -267,6 +292,7 @@ func (r *TemplateGroupResource) Read(ctx context.Context, req resource.ReadReque
 
 	updatedState := updateState(&state, *res)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedState)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TemplateGroupIdentity{GroupID: updatedState.GroupID})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -279,6 +305,9 @@ func (r *TemplateGroupResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *TemplateGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { //nolint:dupl
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
 	var plan, state TemplateGroupConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(plan.ExtractConfigs(ctx)...)
@@ -307,6 +336,7 @@ func (r *TemplateGroupResource) Update(ctx context.Context, req resource.UpdateR
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TemplateGroupIdentity{GroupID: plan.GroupID})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state after update", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -320,6 +350,9 @@ func (r *TemplateGroupResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *TemplateGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
 	var state TemplateGroupConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -345,7 +378,7 @@ func (r *TemplateGroupResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *TemplateGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("group_id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("group_id"), path.Root("group_id"), req, resp)
 }
 
 func prepareTemplateGroupModel(ctx context.Context, plan TemplateGroupConfig) (*cidaas.TemplateGroupModel, diag.Diagnostics) {
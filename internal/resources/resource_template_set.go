@@ -0,0 +1,490 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type TemplateSetResource struct {
+	BaseResource
+}
+
+func NewTemplateSetResource() resource.Resource {
+	return &TemplateSetResource{
+		BaseResource: NewBaseResource(
+			BaseResourceConfig{
+				Name:   RESOURCE_TEMPLATE_SET,
+				Schema: &templateSetSchema,
+			},
+		),
+	}
+}
+
+type TemplateSetConfig struct {
+	ID           types.String   `tfsdk:"id"`
+	TemplateKey  types.String   `tfsdk:"template_key"`
+	TemplateType types.String   `tfsdk:"template_type"`
+	UsageType    types.String   `tfsdk:"usage_type"`
+	Locales      types.Map      `tfsdk:"locales"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+type TemplateSetLocale struct {
+	Subject types.String `tfsdk:"subject"`
+	Content types.String `tfsdk:"content"`
+}
+
+var templateSetLocaleType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"subject": types.StringType,
+		"content": types.StringType,
+	},
+}
+
+var templateSetSchema = schema.Schema{
+	MarkdownDescription: "The `cidaas_template_set` resource manages the custom templates of one `template_key` and `template_type` in multiple locales." +
+		" Each entry of `locales` creates the template of a locale, locales removed from the map are deleted in cidaas." +
+		" Locales that are configured in cidaas but not in `locales` are left untouched, except on import where all existing locales are added to the state." +
+		"\n\n The resource must not be used together with a `cidaas_template` resource for the same `template_key`, `template_type` and `locale`." +
+		" System templates are not supported." +
+		"\n\n Ensure that the below scopes are assigned to the client with the specified `client_id`:" +
+		"\n- cidaas:templates_read" +
+		"\n- cidaas:templates_write" +
+		"\n- cidaas:templates_delete",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the template set in the format `template_key:template_type`.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"template_key": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "The unique name of the template. It cannot be updated for an existing state.",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(
+					regexp.MustCompile(`^[A-Z0-9_-]+$`),
+					`must be a valid string consisting only of uppercase letters, digits (0-9), underscores (_), and hyphens (-). Example: SAMPLE, 12345, SAMPLE-TEMPLATE, SAMPLE_TEMPLATE, SAMPLE12345, SAMPLE-1234`,
+				),
+			},
+			PlanModifiers: []planmodifier.String{
+				&validators.UniqueIdentifier{},
+			},
+		},
+		"template_type": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "The type of the templates. Allowed template_types are EMAIL, SMS, IVR and PUSH. Template types are case sensitive. It cannot be updated for an existing state.",
+			Validators: []validator.String{
+				stringvalidator.OneOf(allowedTemplateTypes...),
+			},
+			PlanModifiers: []planmodifier.String{
+				&validators.UniqueIdentifier{},
+			},
+		},
+		"usage_type": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "The usage type of the templates. If not provided, the usage type assigned by cidaas is used. It cannot be updated for an existing state.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				&validators.UniqueIdentifier{},
+			},
+		},
+		"locales": schema.MapNestedAttribute{
+			Required: true,
+			MarkdownDescription: "The templates by locale. The key is the locale of the template in lowercase, e.g. `en-us`, `de-de`." +
+				" Find the allowed locales in the Allowed Locales section of the `cidaas_template` resource.",
+			Validators: []validator.Map{
+				mapvalidator.SizeAtLeast(1),
+				mapvalidator.KeysAre(
+					stringvalidator.OneOf(
+						func() []string {
+							validLocals := make([]string, len(util.Locales))
+							for i, locale := range util.Locales {
+								validLocals[i] = strings.ToLower(locale.LocaleString)
+							}
+							return validLocals
+						}()...),
+				),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"subject": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Applicable only for template_type EMAIL. It represents the subject of an email.",
+					},
+					"content": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The content of the template.",
+					},
+				},
+			},
+		},
+	},
+}
+
+func (r *TemplateSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config TemplateSetConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.TemplateType.IsUnknown() || config.Locales.IsNull() || config.Locales.IsUnknown() {
+		return
+	}
+
+	locales := map[string]TemplateSetLocale{}
+	resp.Diagnostics.Append(config.Locales.ElementsAs(ctx, &locales, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for locale, template := range locales {
+		subjectPath := path.Root("locales").AtMapKey(locale).AtName("subject")
+		if config.TemplateType.ValueString() == "EMAIL" && template.Subject.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				subjectPath,
+				"Unexpected Resource Configuration",
+				fmt.Sprintf("The attribute subject can not be empty for the locale %s when template_type is EMAIL", locale),
+			)
+		}
+		if config.TemplateType.ValueString() != "EMAIL" && !template.Subject.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				subjectPath,
+				"Unexpected Resource Configuration",
+				fmt.Sprintf("The attribute subject of the locale %s is only allowed when template_type is EMAIL", locale),
+			)
+		}
+	}
+}
+
+type TemplateSetIdentity struct {
+	TemplateKey  types.String `tfsdk:"template_key"`
+	TemplateType types.String `tfsdk:"template_type"`
+}
+
+func (i TemplateSetIdentity) importID() string {
+	return i.TemplateKey.ValueString() + ":" + i.TemplateType.ValueString()
+}
+
+func (r *TemplateSetResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"template_key": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The key of the templates, e.g. `VERIFY_USER`.",
+			},
+			"template_type": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The type of the templates, e.g. `EMAIL`.",
+			},
+		},
+	}
+}
+
+func (r *TemplateSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
+	var plan TemplateSetConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get plan data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	planLocales := map[string]TemplateSetLocale{}
+	resp.Diagnostics.Append(plan.Locales.ElementsAs(ctx, &planLocales, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.reconcileLocales(ctx, &plan, planLocales, map[string]TemplateSetLocale{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", plan.TemplateKey.ValueString(), plan.TemplateType.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TemplateSetIdentity{TemplateKey: plan.TemplateKey, TemplateType: plan.TemplateType})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "resource template_set created successfully", util.H{
+		"id":            plan.ID.ValueString(),
+		"locales_count": len(planLocales),
+	})
+}
+
+func (r *TemplateSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
+	var state TemplateSetConfig
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get state data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	templates, err := r.cidaasClient.Templates.GetAllLocales(ctx, state.TemplateKey.ValueString(), state.TemplateType.ValueString())
+	if err != nil {
+		tflog.Error(ctx, "failed to read templates via API", util.H{
+			"template_key":  state.TemplateKey.ValueString(),
+			"template_type": state.TemplateType.ValueString(),
+			"error":         err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to read template set", util.FormatErrorMessage(err))
+		return
+	}
+
+	// on import all existing locales are adopted, otherwise only the managed locales are read
+	isImport := state.Locales.IsNull()
+	stateLocales := map[string]TemplateSetLocale{}
+	if !isImport {
+		resp.Diagnostics.Append(state.Locales.ElementsAs(ctx, &stateLocales, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	locales := map[string]TemplateSetLocale{}
+	for _, template := range templates {
+		locale := strings.ToLower(template.Locale)
+		if _, ok := stateLocales[locale]; !ok && !isImport {
+			continue
+		}
+		locales[locale] = TemplateSetLocale{
+			Subject: util.StringValueOrNull(&template.Subject),
+			Content: util.StringValueOrNull(&template.Content),
+		}
+		if state.UsageType.IsNull() || state.UsageType.IsUnknown() {
+			state.UsageType = util.StringValueOrNull(&template.UsageType)
+		}
+	}
+
+	if len(locales) == 0 {
+		tflog.Warn(ctx, "no templates found for the template set, removing resource from state", util.H{
+			"template_key":  state.TemplateKey.ValueString(),
+			"template_type": state.TemplateType.ValueString(),
+		})
+		// terraform requires the identity of a resource state which did not have one yet even if it is removed
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, TemplateSetIdentity{TemplateKey: state.TemplateKey, TemplateType: state.TemplateType})...)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var diags diag.Diagnostics
+	state.Locales, diags = types.MapValueFrom(ctx, templateSetLocaleType, locales)
+	resp.Diagnostics.Append(diags...)
+	state.ID = types.StringValue(fmt.Sprintf("%s:%s", state.TemplateKey.ValueString(), state.TemplateType.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TemplateSetIdentity{TemplateKey: state.TemplateKey, TemplateType: state.TemplateType})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Debug(ctx, "resource template_set read successfully", util.H{
+		"id":            state.ID.ValueString(),
+		"locales_count": len(locales),
+	})
+}
+
+func (r *TemplateSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
+	var plan, state TemplateSetConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get plan/state data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	planLocales := map[string]TemplateSetLocale{}
+	stateLocales := map[string]TemplateSetLocale{}
+	resp.Diagnostics.Append(plan.Locales.ElementsAs(ctx, &planLocales, false)...)
+	resp.Diagnostics.Append(state.Locales.ElementsAs(ctx, &stateLocales, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.reconcileLocales(ctx, &plan, planLocales, stateLocales)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TemplateSetIdentity{TemplateKey: plan.TemplateKey, TemplateType: plan.TemplateType})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state after update", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Debug(ctx, "resource template_set updated successfully", util.H{
+		"id":            plan.ID.ValueString(),
+		"locales_count": len(planLocales),
+	})
+}
+
+func (r *TemplateSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
+	var state TemplateSetConfig
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get state data for deletion", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	stateLocales := map[string]TemplateSetLocale{}
+	resp.Diagnostics.Append(state.Locales.ElementsAs(ctx, &stateLocales, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for locale := range stateLocales {
+		err := r.cidaasClient.Templates.DeleteLocale(ctx, state.TemplateKey.ValueString(), state.TemplateType.ValueString(), locale)
+		if err != nil {
+			tflog.Error(ctx, "failed to delete template locale via API", util.H{
+				"id":     state.ID.ValueString(),
+				"locale": locale,
+				"error":  err.Error(),
+			})
+			resp.Diagnostics.AddError("failed to delete template set", util.FormatErrorMessage(err))
+			return
+		}
+	}
+
+	tflog.Info(ctx, "resource template_set deleted successfully", util.H{
+		"id": state.ID.ValueString(),
+	})
+}
+
+func (r *TemplateSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := importID[TemplateSetIdentity](ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: 'template_key:template_type', got: %s", id),
+		)
+		return
+	}
+	if !util.Contains(allowedTemplateTypes, parts[1]) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Invalid template_type provided in import identifier. Valid template_types %+v, got: %s", allowedTemplateTypes, parts[1]),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("template_key"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("template_type"), parts[1])...)
+}
+
+// reconcileLocales creates the new locales, updates the changed ones and deletes the locales removed from the configuration.
+func (r *TemplateSetResource) reconcileLocales(ctx context.Context, plan *TemplateSetConfig, planLocales, stateLocales map[string]TemplateSetLocale) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// sorted to upsert the locales in a stable order
+	locales := make([]string, 0, len(planLocales))
+	for locale := range planLocales {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+
+	usageType := plan.UsageType.ValueString()
+	for _, locale := range locales {
+		template := planLocales[locale]
+		if current, ok := stateLocales[locale]; ok && current.Subject.Equal(template.Subject) && current.Content.Equal(template.Content) {
+			continue
+		}
+		res, err := r.cidaasClient.Templates.Upsert(ctx, cidaas.TemplateModel{
+			Locale:       locale,
+			TemplateKey:  plan.TemplateKey.ValueString(),
+			TemplateType: plan.TemplateType.ValueString(),
+			Content:      template.Content.ValueString(),
+			Subject:      template.Subject.ValueString(),
+			UsageType:    usageType,
+			Enabled:      true,
+		}, false)
+		if err != nil {
+			tflog.Error(ctx, "failed to upsert template locale via API", util.H{
+				"template_key": plan.TemplateKey.ValueString(),
+				"locale":       locale,
+				"error":        err.Error(),
+			})
+			diags.AddError(fmt.Sprintf("failed to upsert template for the locale %s", locale), util.FormatErrorMessage(err))
+			return diags
+		}
+		if usageType == "" {
+			usageType = res.Data.UsageType
+		}
+		tflog.Info(ctx, "successfully upserted template locale via API", util.H{
+			"template_key": plan.TemplateKey.ValueString(),
+			"locale":       locale,
+		})
+	}
+
+	for locale := range stateLocales {
+		if _, ok := planLocales[locale]; ok {
+			continue
+		}
+		err := r.cidaasClient.Templates.DeleteLocale(ctx, plan.TemplateKey.ValueString(), plan.TemplateType.ValueString(), locale)
+		if err != nil {
+			tflog.Error(ctx, "failed to delete template locale via API", util.H{
+				"template_key": plan.TemplateKey.ValueString(),
+				"locale":       locale,
+				"error":        err.Error(),
+			})
+			diags.AddError(fmt.Sprintf("failed to delete template for the locale %s", locale), util.FormatErrorMessage(err))
+			return diags
+		}
+		tflog.Info(ctx, "deleted template locale removed from the configuration", util.H{
+			"template_key": plan.TemplateKey.ValueString(),
+			"locale":       locale,
+		})
+	}
+
+	if plan.UsageType.IsUnknown() {
+		plan.UsageType = util.StringValueOrNull(&usageType)
+	}
+	return diags
+}
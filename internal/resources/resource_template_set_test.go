@@ -0,0 +1,127 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	acctest "github.com/Cidaas/terraform-provider-cidaas/internal/test"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// create, add and remove a locale, update and import test
+func TestAccTemplateSetResource_Basic(t *testing.T) {
+	t.Parallel()
+
+	testResourceID := acctest.RandString(10)
+	templateKey := strings.ToUpper(acctest.RandString(10))
+	testResourceName := fmt.Sprintf("%s.%s", resources.RESOURCE_TEMPLATE_SET, testResourceID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateSetConfig(testResourceID, templateKey, `
+					"en-us" = {
+						subject = "Welcome"
+						content = "Welcome {{name}}"
+					}
+					"de-de" = {
+						subject = "Willkommen"
+						content = "Willkommen {{name}}"
+					}
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "id", templateKey+":EMAIL"),
+					resource.TestCheckResourceAttr(testResourceName, "locales.%", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "locales.de-de.subject", "Willkommen"),
+					resource.TestCheckResourceAttrSet(testResourceName, "usage_type"),
+				),
+			},
+			// de-de is deleted, fr-fr is created and en-us is updated
+			{
+				Config: testAccTemplateSetConfig(testResourceID, templateKey, `
+					"en-us" = {
+						subject = "Welcome"
+						content = "Welcome {{name}}, updated"
+					}
+					"fr-fr" = {
+						subject = "Bienvenue"
+						content = "Bienvenue {{name}}"
+					}
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "locales.%", "2"),
+					resource.TestCheckNoResourceAttr(testResourceName, "locales.de-de.content"),
+					resource.TestCheckResourceAttr(testResourceName, "locales.en-us.content", "Welcome {{name}}, updated"),
+					resource.TestCheckResourceAttr(testResourceName, "locales.fr-fr.content", "Bienvenue {{name}}"),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateId:     templateKey + ":EMAIL",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTemplateSetConfig(resourceID, templateKey, locales string) string {
+	return fmt.Sprintf(`
+		provider "cidaas" {
+			base_url = "%s"
+		}
+		resource "cidaas_template_set" "%s" {
+			template_key  = "%s"
+			template_type = "EMAIL"
+			locales = {
+				%s
+			}
+		}
+	`, acctest.GetBaseURL(), resourceID, templateKey, locales)
+}
+
+// subject is required for every locale of an EMAIL template set
+func TestAccTemplateSetResource_MissingSubject(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateSetConfig(acctest.RandString(10), "SAMPLE_KEY", `
+					"en-us" = {
+						content = "Welcome {{name}}"
+					}
+				`),
+				ExpectError: regexp.MustCompile("subject can not be empty for the locale en-us"),
+			},
+		},
+	})
+}
+
+// locale keys must be valid lowercase locales
+func TestAccTemplateSetResource_InvalidLocale(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateSetConfig(acctest.RandString(10), "SAMPLE_KEY", `
+					"EN-US" = {
+						subject = "Welcome"
+						content = "Welcome {{name}}"
+					}
+				`),
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
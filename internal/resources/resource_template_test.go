@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"os"
 	"regexp"
 	"strconv"
 	"strings"
@@ -86,7 +85,7 @@ func checkTemplateDestroyed(resourceName string) resource.TestCheckFunc {
 
 		template := cidaas.Template{
 			ClientConfig: cidaas.ClientConfig{
-				BaseURL:     os.Getenv("BASE_URL"),
+				BaseURL:     acctest.GetBaseURL(),
 				AccessToken: acctest.TestToken,
 			},
 		}
@@ -303,3 +302,55 @@ func TestTemplate_SystemTemplateBasic(t *testing.T) {
 		},
 	})
 }
+
+// placeholders of a system template are validated against the supported tags during plan
+func TestTemplate_SystemTemplatePlaceholderValidation(t *testing.T) {
+	t.Parallel()
+
+	testResourceID := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				provider "cidaas" {
+					base_url = "%s"
+				}
+				resource "cidaas_template" "%s" {
+					locale             = "en-us"
+					template_key       = "VERIFY_USER"
+					template_type      = "SMS"
+					content            = "Hi {{name}}, please verify the user"
+					is_system_template = true
+					group_id           = "sample_group"
+					processing_type    = "GENERAL"
+					verification_type  = "SMS"
+					usage_type         = "VERIFICATION_CONFIGURATION"
+				}
+				`, acctest.GetBaseURL(), testResourceID),
+				ExpectError: regexp.MustCompile("Missing Required Template Tags"),
+			},
+			{
+				Config: fmt.Sprintf(`
+				provider "cidaas" {
+					base_url = "%s"
+				}
+				resource "cidaas_template" "%s" {
+					locale             = "en-us"
+					template_key       = "VERIFY_USER"
+					template_type      = "SMS"
+					content            = "Hi {{name}}, here is the {{code}} for {{unknown_tag}}"
+					is_system_template = true
+					group_id           = "sample_group"
+					processing_type    = "GENERAL"
+					verification_type  = "SMS"
+					usage_type         = "VERIFICATION_CONFIGURATION"
+				}
+				`, acctest.GetBaseURL(), testResourceID),
+				ExpectError: regexp.MustCompile("Unsupported Template Tags"),
+			},
+		},
+	})
+}
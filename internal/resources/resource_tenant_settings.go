@@ -0,0 +1,327 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type TenantSettingsResource struct {
+	BaseResource
+}
+
+func NewTenantSettingsResource() resource.Resource {
+	return &TenantSettingsResource{
+		BaseResource: NewBaseResource(
+			BaseResourceConfig{
+				Name:   RESOURCE_TENANT_SETTINGS,
+				Schema: &tenantSettingsSchema,
+			},
+		),
+	}
+}
+
+type TenantSettingsConfig struct {
+	ID             types.String   `tfsdk:"id"`
+	TenantKey      types.String   `tfsdk:"tenant_key"`
+	TenantName     types.String   `tfsdk:"tenant_name"`
+	CustomDomain   types.String   `tfsdk:"custom_domain"`
+	DefaultLocale  types.String   `tfsdk:"default_locale"`
+	LegalEntity    types.String   `tfsdk:"legal_entity"`
+	CompanyName    types.String   `tfsdk:"company_name"`
+	CompanyAddress types.String   `tfsdk:"company_address"`
+	CompanyWebsite types.String   `tfsdk:"company_website"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// tenantSettingAttribute returns an optional attribute that adopts the existing tenant value when it is not configured.
+func tenantSettingAttribute(description string, validators ...validator.String) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: description,
+		Validators:          validators,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+var tenantSettingsSchema = schema.Schema{
+	MarkdownDescription: "The `cidaas_tenant_settings` resource manages the basic settings of the tenant the provider is configured for." +
+		" The tenant settings exist for as long as the tenant exists, therefore the resource behaves as a singleton:" +
+		"\n- Only one `cidaas_tenant_settings` resource should be declared per tenant." +
+		"\n- Create adopts the existing settings. Attributes that are not configured keep their current value in cidaas." +
+		"\n- Delete only removes the resource from the Terraform state. The tenant settings remain unchanged in cidaas." +
+		"\n\n Ensure that the below scopes are assigned to the client with the specified `client_id`:" +
+		"\n- cidaas:tenant_read" +
+		"\n- cidaas:tenant_write",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the tenant settings resource. It is the same as the tenant_key.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"tenant_key": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique key of the tenant.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"tenant_name": tenantSettingAttribute(
+			"The display name of the tenant.",
+			stringvalidator.LengthAtLeast(1),
+		),
+		"custom_domain": tenantSettingAttribute(
+			"The custom domain under which the tenant is reachable, e.g. `login.example.com`.",
+		),
+		"default_locale": tenantSettingAttribute(
+			"The default locale of the tenant, e.g. `en-US`.",
+			stringvalidator.OneOf(
+				func() []string {
+					validLocals := make([]string, len(util.Locales))
+					for i, locale := range util.Locales {
+						validLocals[i] = locale.LocaleString
+					}
+					return validLocals
+				}()...),
+		),
+		"legal_entity": tenantSettingAttribute(
+			"The legal entity operating the tenant.",
+		),
+		"company_name": tenantSettingAttribute(
+			"The name of the company.",
+		),
+		"company_address": tenantSettingAttribute(
+			"The address of the company.",
+		),
+		"company_website": tenantSettingAttribute(
+			"The URL of the company's website.",
+		),
+	},
+}
+
+type TenantSettingsIdentity struct {
+	TenantKey types.String `tfsdk:"tenant_key"`
+}
+
+func (r *TenantSettingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"tenant_key": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The key of the tenant.",
+			},
+		},
+	}
+}
+
+func (r *TenantSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
+	var plan TenantSettingsConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get plan data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	// the tenant settings always exist, so create adopts them and applies the configured values on top
+	current, err := r.cidaasClient.Tenant.Get(ctx)
+	if err != nil {
+		tflog.Error(ctx, "failed to read existing tenant settings via API", util.H{
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to read existing tenant settings", util.FormatErrorMessage(err))
+		return
+	}
+	tflog.Info(ctx, "adopting existing tenant settings", util.H{
+		"tenant_key": current.Data.TenantKey,
+	})
+
+	res, err := r.cidaasClient.Tenant.Update(ctx, prepareTenantSettingsPayload(plan, current.Data))
+	if err != nil {
+		tflog.Error(ctx, "failed to update tenant settings via API", util.H{
+			"tenant_key": current.Data.TenantKey,
+			"error":      err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to create tenant settings", util.FormatErrorMessage(err))
+		return
+	}
+
+	plan.applyResponse(res.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TenantSettingsIdentity{TenantKey: plan.ID})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "resource tenant_settings created successfully", util.H{
+		"tenant_key": res.Data.TenantKey,
+	})
+}
+
+func (r *TenantSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
+	var state TenantSettingsConfig
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get state data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	res, err := r.cidaasClient.Tenant.Get(ctx)
+	if err != nil {
+		tflog.Error(ctx, "failed to read tenant settings via API", util.H{
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to read tenant settings", util.FormatErrorMessage(err))
+		return
+	}
+
+	state.ID = util.StringValueOrNull(&res.Data.TenantKey)
+	state.TenantKey = util.StringValueOrNull(&res.Data.TenantKey)
+	state.TenantName = util.StringValueOrNull(&res.Data.TenantName)
+	state.CustomDomain = util.StringValueOrNull(&res.Data.CustomDomain)
+	state.DefaultLocale = util.StringValueOrNull(&res.Data.DefaultLocale)
+	state.LegalEntity = util.StringValueOrNull(&res.Data.LegalEntity)
+	state.CompanyName = util.StringValueOrNull(&res.Data.CompanyName)
+	state.CompanyAddress = util.StringValueOrNull(&res.Data.CompanyAddress)
+	state.CompanyWebsite = util.StringValueOrNull(&res.Data.CompanyWebsite)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TenantSettingsIdentity{TenantKey: state.ID})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Debug(ctx, "resource tenant_settings read successfully", util.H{
+		"tenant_key": res.Data.TenantKey,
+	})
+}
+
+func (r *TenantSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
+	var plan, state TenantSettingsConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get plan or state data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	current := cidaas.TenantInfoModel{
+		TenantKey:      state.TenantKey.ValueString(),
+		TenantName:     state.TenantName.ValueString(),
+		CustomDomain:   state.CustomDomain.ValueString(),
+		DefaultLocale:  state.DefaultLocale.ValueString(),
+		LegalEntity:    state.LegalEntity.ValueString(),
+		CompanyName:    state.CompanyName.ValueString(),
+		CompanyAddress: state.CompanyAddress.ValueString(),
+		CompanyWebsite: state.CompanyWebsite.ValueString(),
+	}
+	res, err := r.cidaasClient.Tenant.Update(ctx, prepareTenantSettingsPayload(plan, current))
+	if err != nil {
+		tflog.Error(ctx, "failed to update tenant settings via API", util.H{
+			"tenant_key": state.TenantKey.ValueString(),
+			"error":      err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to update tenant settings", util.FormatErrorMessage(err))
+		return
+	}
+
+	plan.applyResponse(res.Data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, TenantSettingsIdentity{TenantKey: plan.ID})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state after update", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Debug(ctx, "resource tenant_settings updated successfully", util.H{
+		"tenant_key": state.TenantKey.ValueString(),
+	})
+}
+
+func (r *TenantSettingsResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.AddWarning(
+		"The cidaas_tenant_settings state has been destroyed. However, the tenant settings remain unchanged in cidaas.",
+		"The tenant settings exist for as long as the tenant exists and cannot be deleted. To manage them again, declare a cidaas_tenant_settings resource.",
+	)
+	tflog.Info(ctx, "resource tenant_settings removed from state")
+}
+
+func (r *TenantSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// the import identifier is only a placeholder as there is exactly one tenant per provider configuration
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("tenant_key"), req, resp)
+}
+
+// prepareTenantSettingsPayload applies the known plan values on top of the current tenant settings.
+func prepareTenantSettingsPayload(plan TenantSettingsConfig, current cidaas.TenantInfoModel) cidaas.TenantInfoModel {
+	payload := current
+	setIfKnown := func(value types.String, target *string) {
+		if !value.IsUnknown() && !value.IsNull() {
+			*target = value.ValueString()
+		}
+	}
+	setIfKnown(plan.TenantName, &payload.TenantName)
+	setIfKnown(plan.CustomDomain, &payload.CustomDomain)
+	setIfKnown(plan.DefaultLocale, &payload.DefaultLocale)
+	setIfKnown(plan.LegalEntity, &payload.LegalEntity)
+	setIfKnown(plan.CompanyName, &payload.CompanyName)
+	setIfKnown(plan.CompanyAddress, &payload.CompanyAddress)
+	setIfKnown(plan.CompanyWebsite, &payload.CompanyWebsite)
+	return payload
+}
+
+// applyResponse resolves the computed attributes of the plan from the api response.
+func (t *TenantSettingsConfig) applyResponse(data cidaas.TenantInfoModel) {
+	t.ID = util.StringValueOrNull(&data.TenantKey)
+	t.TenantKey = util.StringValueOrNull(&data.TenantKey)
+	resolve := func(value *types.String, apiValue string) {
+		if value.IsUnknown() {
+			*value = util.StringValueOrNull(&apiValue)
+		}
+	}
+	resolve(&t.TenantName, data.TenantName)
+	resolve(&t.CustomDomain, data.CustomDomain)
+	resolve(&t.DefaultLocale, data.DefaultLocale)
+	resolve(&t.LegalEntity, data.LegalEntity)
+	resolve(&t.CompanyName, data.CompanyName)
+	resolve(&t.CompanyAddress, data.CompanyAddress)
+	resolve(&t.CompanyWebsite, data.CompanyWebsite)
+}
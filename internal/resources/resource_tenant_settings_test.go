@@ -0,0 +1,82 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	acctest "github.com/Cidaas/terraform-provider-cidaas/internal/test"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// create, read, import and update test
+// the test is not run in parallel as the tenant settings are a singleton
+func TestAccTenantSettingsResource_Basic(t *testing.T) {
+	testResourceID := acctest.RandString(10)
+	testResourceName := fmt.Sprintf("%s.%s", resources.RESOURCE_TENANT_SETTINGS, testResourceID)
+	companyName := acctest.RandString(10)
+	updatedCompanyName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTenantSettingsResourceConfig(testResourceID, companyName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "company_name", companyName),
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "tenant_key"),
+					resource.TestCheckResourceAttrSet(testResourceName, "tenant_name"),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "tenant",
+			},
+			{
+				Config: testAccTenantSettingsResourceConfig(testResourceID, updatedCompanyName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "company_name", updatedCompanyName),
+				),
+			},
+		},
+	})
+}
+
+func testAccTenantSettingsResourceConfig(resourceID, companyName string) string {
+	return fmt.Sprintf(`
+		provider "cidaas" {
+			base_url = "%s"
+		}
+		resource "cidaas_tenant_settings" "%s" {
+			company_name = "%s"
+		}
+	`, acctest.GetBaseURL(), resourceID, companyName)
+}
+
+// default_locale must be one of the supported locales
+func TestAccTenantSettingsResource_InvalidDefaultLocale(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				provider "cidaas" {
+					base_url = "%s"
+				}
+				resource "cidaas_tenant_settings" "sample" {
+					default_locale = "invalid"
+				}
+				`, acctest.GetBaseURL()),
+				ExpectError: regexp.MustCompile(`Attribute default_locale value must be one of`),
+			},
+		},
+	})
+}
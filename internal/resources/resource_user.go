@@ -0,0 +1,370 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type UserResource struct {
+	BaseResource
+}
+
+func NewUserResource() resource.Resource {
+	return &UserResource{
+		BaseResource: NewBaseResource(
+			BaseResourceConfig{
+				Name:   RESOURCE_USER,
+				Schema: &userSchema,
+			},
+		),
+	}
+}
+
+type UserConfig struct {
+	ID              types.String   `tfsdk:"id"`
+	Sub             types.String   `tfsdk:"sub"`
+	Email           types.String   `tfsdk:"email"`
+	MobileNumber    types.String   `tfsdk:"mobile_number"`
+	GivenName       types.String   `tfsdk:"given_name"`
+	FamilyName      types.String   `tfsdk:"family_name"`
+	CustomFields    types.Map      `tfsdk:"custom_fields"`
+	InitialPassword types.String   `tfsdk:"initial_password"`
+	Status          types.String   `tfsdk:"status"`
+	CreatedAt       types.String   `tfsdk:"created_at"`
+	UpdatedAt       types.String   `tfsdk:"updated_at"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+var userSchema = schema.Schema{
+	MarkdownDescription: "The `cidaas_user` resource manages users in cidaas, for example service or test accounts and group admins." +
+		" The `initial_password` is a write-only attribute. It is only sent when the user is created and is never stored in the Terraform state." +
+		" Write-only attributes require Terraform 1.11 or later." +
+		"\n\n Ensure that the below scopes are assigned to the client with the specified `client_id`:" +
+		"\n- cidaas:users_read" +
+		"\n- cidaas:users_write" +
+		"\n- cidaas:users_delete",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the user resource. It is the same as the sub.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"sub": schema.StringAttribute{
+			Computed:    true,
+			Description: "The sub of the user generated by cidaas. It is used to import an existing user.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"email": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "The email address of the user. Either `email` or `mobile_number` must be configured.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.AtLeastOneOf(path.MatchRoot("mobile_number")),
+			},
+		},
+		"mobile_number": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "The mobile number of the user in international format, e.g. `+4915112345678`.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"given_name": schema.StringAttribute{
+			Optional:    true,
+			Description: "The given name of the user.",
+		},
+		"family_name": schema.StringAttribute{
+			Optional:    true,
+			Description: "The family name of the user.",
+		},
+		"custom_fields": schema.MapAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			MarkdownDescription: "The custom fields of the user. The keys must be registration fields configured in cidaas," +
+				" for example the `field_key` of a `cidaas_registration_field` resource.",
+		},
+		"initial_password": schema.StringAttribute{
+			Optional:  true,
+			Sensitive: true,
+			WriteOnly: true,
+			MarkdownDescription: "The password the user is created with. The value is write-only and is only used when the user is created." +
+				" Changing it afterwards has no effect, the user is expected to change the password on their own.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"status": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "The status of the user. Allowed values are `VERIFIED`, `PENDING`, `DECLINED` and `COMPROMISED`." +
+				" If not configured, cidaas assigns the status.",
+			Validators: []validator.String{
+				stringvalidator.OneOf(cidaas.AllowedUserStatus...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "The timestamp when the resource was created.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "The timestamp when the resource was last updated.",
+		},
+	},
+}
+
+type UserIdentity struct {
+	Sub types.String `tfsdk:"sub"`
+}
+
+func (r *UserResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"sub": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique identifier of the user.",
+			},
+		},
+	}
+}
+
+func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
+	var plan UserConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// write-only attributes are always null in the plan and must be read from the config
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("initial_password"), &plan.InitialPassword)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get plan data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	user, diags := prepareUserModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to prepare user payload", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+	user.Password = plan.InitialPassword.ValueString()
+	user.PasswordEcho = plan.InitialPassword.ValueString()
+	user.Provider = "self"
+
+	res, err := r.cidaasClient.Users.Create(ctx, user)
+	if err != nil {
+		tflog.Error(ctx, "failed to create user via API", util.H{
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to create user", util.FormatErrorMessage(err))
+		return
+	}
+	tflog.Info(ctx, "successfully created user via API", util.H{
+		"sub": res.Data.Sub,
+	})
+
+	plan.InitialPassword = types.StringNull()
+	plan.ID = util.StringValueOrNull(&res.Data.Sub)
+	plan.Sub = util.StringValueOrNull(&res.Data.Sub)
+	if plan.Status.IsUnknown() {
+		plan.Status = util.StringValueOrNull(&res.Data.UserStatus)
+	}
+	plan.CreatedAt = util.StringValueOrNull(&res.Data.CreatedTime)
+	plan.UpdatedAt = util.StringValueOrNull(&res.Data.UpdatedTime)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UserIdentity{Sub: plan.Sub})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "resource user created successfully", util.H{
+		"sub": plan.Sub.ValueString(),
+	})
+}
+
+func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
+	var state UserConfig
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get state data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	res, err := r.cidaasClient.Users.Get(ctx, state.Sub.ValueString())
+	if err != nil {
+		tflog.Error(ctx, "failed to read user via API", util.H{
+			"sub":   state.Sub.ValueString(),
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to read user", util.FormatErrorMessage(err))
+		return
+	}
+
+	customFields, diags := util.MapValueOrNull(&res.Data.CustomFields)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = util.StringValueOrNull(&res.Data.Sub)
+	state.Sub = util.StringValueOrNull(&res.Data.Sub)
+	state.Email = util.StringValueOrNull(&res.Data.Email)
+	state.MobileNumber = util.StringValueOrNull(&res.Data.MobileNumber)
+	state.GivenName = util.StringValueOrNull(&res.Data.GivenName)
+	state.FamilyName = util.StringValueOrNull(&res.Data.FamilyName)
+	state.CustomFields = customFields
+	state.Status = util.StringValueOrNull(&res.Data.UserStatus)
+	state.CreatedAt = util.StringValueOrNull(&res.Data.CreatedTime)
+	state.UpdatedAt = util.StringValueOrNull(&res.Data.UpdatedTime)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UserIdentity{Sub: state.Sub})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Debug(ctx, "resource user read successfully", util.H{
+		"sub": res.Data.Sub,
+	})
+}
+
+func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
+	var plan, state UserConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get plan or state data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	user, diags := prepareUserModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to prepare user payload for update", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+	user.Sub = state.Sub.ValueString()
+
+	res, err := r.cidaasClient.Users.Update(ctx, user)
+	if err != nil {
+		tflog.Error(ctx, "failed to update user via API", util.H{
+			"sub":   user.Sub,
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to update user", util.FormatErrorMessage(err))
+		return
+	}
+	tflog.Info(ctx, "successfully updated user via API", util.H{
+		"sub": user.Sub,
+	})
+
+	if plan.Status.IsUnknown() {
+		plan.Status = util.StringValueOrNull(&res.Data.UserStatus)
+	}
+	plan.UpdatedAt = util.StringValueOrNull(&res.Data.UpdatedTime)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UserIdentity{Sub: plan.Sub})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state after update", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Debug(ctx, "resource user updated successfully", util.H{
+		"sub": user.Sub,
+	})
+}
+
+func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
+	var state UserConfig
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get state data for deletion", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	err := r.cidaasClient.Users.Delete(ctx, state.Sub.ValueString())
+	if err != nil {
+		tflog.Error(ctx, "failed to delete user via API", util.H{
+			"sub":   state.Sub.ValueString(),
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to delete user", util.FormatErrorMessage(err))
+		return
+	}
+
+	tflog.Info(ctx, "resource user deleted successfully", util.H{
+		"sub": state.Sub.ValueString(),
+	})
+}
+
+func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("sub"), path.Root("sub"), req, resp)
+}
+
+func prepareUserModel(ctx context.Context, plan UserConfig) (cidaas.UserModel, diag.Diagnostics) {
+	user := cidaas.UserModel{
+		Email:        plan.Email.ValueString(),
+		MobileNumber: plan.MobileNumber.ValueString(),
+		GivenName:    plan.GivenName.ValueString(),
+		FamilyName:   plan.FamilyName.ValueString(),
+	}
+	if !plan.Status.IsUnknown() {
+		user.UserStatus = plan.Status.ValueString()
+	}
+	diags := plan.CustomFields.ElementsAs(ctx, &user.CustomFields, false)
+	return user, diags
+}
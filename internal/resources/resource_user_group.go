@@ -2,14 +2,21 @@ package resources
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
 	"github.com/Cidaas/terraform-provider-cidaas/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -35,24 +42,38 @@ func NewUserGroupResource() resource.Resource {
 	}
 }
 
+// NewUserGroupsResource returns the resource of the legacy type name cidaas_user_groups. It behaves like
+// cidaas_user_group but is deprecated, its state can be moved to cidaas_user_group with a moved block.
+func NewUserGroupsResource() resource.Resource {
+	return &UserGroupResource{
+		BaseResource: NewBaseResource(
+			BaseResourceConfig{
+				Name:   RESOURCE_USER_GROUPS,
+				Schema: &userGroupsSchema,
+			},
+		),
+	}
+}
+
 type UserGroupConfig struct {
-	ID                          types.String `tfsdk:"id"`
-	GroupType                   types.String `tfsdk:"group_type"`
-	GroupID                     types.String `tfsdk:"group_id"`
-	GroupName                   types.String `tfsdk:"group_name"`
-	ParentID                    types.String `tfsdk:"parent_id"`
-	LogoURL                     types.String `tfsdk:"logo_url"`
-	Description                 types.String `tfsdk:"description"`
-	MakeFirstUserAdmin          types.Bool   `tfsdk:"make_first_user_admin"`
-	MemberProfileVisibility     types.String `tfsdk:"member_profile_visibility"`
-	NoneMemberProfileVisibility types.String `tfsdk:"none_member_profile_visibility"`
-	CustomFields                types.Map    `tfsdk:"custom_fields"`
-	CreatedAt                   types.String `tfsdk:"created_at"`
-	UpdatedAt                   types.String `tfsdk:"updated_at"`
+	ID                          types.String   `tfsdk:"id"`
+	GroupType                   types.String   `tfsdk:"group_type"`
+	GroupID                     types.String   `tfsdk:"group_id"`
+	GroupName                   types.String   `tfsdk:"group_name"`
+	ParentID                    types.String   `tfsdk:"parent_id"`
+	LogoURL                     types.String   `tfsdk:"logo_url"`
+	Description                 types.String   `tfsdk:"description"`
+	MakeFirstUserAdmin          types.Bool     `tfsdk:"make_first_user_admin"`
+	MemberProfileVisibility     types.String   `tfsdk:"member_profile_visibility"`
+	NoneMemberProfileVisibility types.String   `tfsdk:"none_member_profile_visibility"`
+	CustomFields                types.Map      `tfsdk:"custom_fields"`
+	CreatedAt                   types.String   `tfsdk:"created_at"`
+	UpdatedAt                   types.String   `tfsdk:"updated_at"`
+	Timeouts                    timeouts.Value `tfsdk:"timeouts"`
 }
 
 var userGroupSchema = schema.Schema{
-	MarkdownDescription: "The cidaas_user_groups resource enables the creation of user groups in the cidaas system." +
+	MarkdownDescription: "The cidaas_user_group resource enables the creation of user groups in the cidaas system." +
 		" These groups allow users to be organized and assigned group-specific roles." +
 		"\n\n Ensure that the below scopes are assigned to the client with the specified `client_id`:" +
 		"\n- cidaas:groups_write" +
@@ -134,9 +155,10 @@ var userGroupSchema = schema.Schema{
 			Default: stringdefault.StaticString("none"),
 		},
 		"custom_fields": schema.MapAttribute{
-			ElementType:         types.StringType,
-			Optional:            true,
-			MarkdownDescription: "Custom fields for the user group.",
+			ElementType: types.StringType,
+			Optional:    true,
+			MarkdownDescription: "Custom fields for the user group. Once group custom fields are defined with the `cidaas_group_custom_field` resource," +
+				" the keys and values are validated against those definitions during plan. Required fields are only checked if `custom_fields` is set.",
 		},
 		"created_at": schema.StringAttribute{
 			Computed:    true,
@@ -155,7 +177,114 @@ var userGroupSchema = schema.Schema{
 	},
 }
 
+var userGroupsSchema = func() schema.Schema {
+	s := userGroupSchema
+	s.MarkdownDescription = "The cidaas_user_groups resource is deprecated, use `cidaas_user_group` instead." +
+		" Existing user groups are moved to the new resource type without recreating them with a `moved` block, see the example below." +
+		" Moving resources between types requires Terraform 1.8 or later." +
+		"\n\n" + userGroupSchema.MarkdownDescription
+	s.DeprecationMessage = "The cidaas_user_groups resource is deprecated and will be removed in a future major version." +
+		" Rename the resource to cidaas_user_group and add a moved block from the cidaas_user_groups address to keep the existing user group," +
+		" e.g. moved { from = cidaas_user_groups.sample, to = cidaas_user_group.sample }. Moving resources between types requires Terraform 1.8 or later."
+	return s
+}()
+
+func (r *UserGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// the field definitions can only be fetched once the provider is configured and are irrelevant on destroy
+	if req.Plan.Raw.IsNull() || r.cidaasClient == nil {
+		return
+	}
+
+	var plan UserGroupConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// the definitions are only fetched when custom_fields is managed by the configuration
+	if resp.Diagnostics.HasError() || plan.CustomFields.IsUnknown() || plan.CustomFields.IsNull() {
+		return
+	}
+
+	fields, err := r.cidaasClient.GroupFields.GetAll(ctx)
+	if err != nil {
+		tflog.Error(ctx, "failed to fetch group custom field definitions", util.H{
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to fetch group custom field definitions", util.FormatErrorMessage(err))
+		return
+	}
+	// custom_fields stay free-form until the tenant defines at least one group custom field
+	if len(fields) == 0 {
+		return
+	}
+
+	// values referencing other resources can be unknown during plan, only their keys are validated
+	customFields := map[string]types.String{}
+	for key, value := range plan.CustomFields.Elements() {
+		if v, ok := value.(types.String); ok {
+			customFields[key] = v
+		}
+	}
+	resp.Diagnostics.Append(validateGroupCustomFields(plan.GroupType, customFields, fields)...)
+}
+
+type UserGroupIdentity struct {
+	GroupID types.String `tfsdk:"group_id"`
+}
+
+func (r *UserGroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"group_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique identifier of the user group.",
+			},
+		},
+	}
+}
+
+// MoveState moves the state of the deprecated cidaas_user_groups resource to cidaas_user_group. Both types
+// share the schema, so the state is taken over unchanged.
+func (r *UserGroupResource) MoveState(ctx context.Context) []resource.StateMover {
+	if r.Config.Name != RESOURCE_USER_GROUP {
+		return nil
+	}
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	return []resource.StateMover{
+		{
+			SourceSchema: &schemaResp.Schema,
+			StateMover:   moveUserGroupsState,
+		},
+	}
+}
+
+func moveUserGroupsState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	// only the provider type is compared, the hostname differs between the registry and local builds
+	if req.SourceTypeName != RESOURCE_USER_GROUPS || !strings.HasSuffix(strings.ToLower(req.SourceProviderAddress), "/cidaas") {
+		return
+	}
+	if req.SourceState == nil {
+		resp.Diagnostics.AddError("Unable to Move Resource State",
+			fmt.Sprintf("The state of %s with schema version %d does not match the schema of %s.", RESOURCE_USER_GROUPS, req.SourceSchemaVersion, RESOURCE_USER_GROUP))
+		return
+	}
+
+	var state UserGroupConfig
+	resp.Diagnostics.Append(req.SourceState.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, &state)...)
+	if resp.TargetIdentity != nil {
+		resp.Diagnostics.Append(resp.TargetIdentity.Set(ctx, UserGroupIdentity{GroupID: state.GroupID})...)
+	}
+	tflog.Info(ctx, "moved user group from the deprecated resource type", util.H{
+		"group_id": state.GroupID.ValueString(),
+	})
+}
+
 func (r *UserGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
 	var plan UserGroupConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	userGroup, diags := prepareUserGroupPayload(ctx, plan)
@@ -179,12 +308,15 @@ func (r *UserGroupResource) Create(ctx context.Context, req resource.CreateReque
 		"group_id": res.Data.ID,
 	})
 
+	r.waitForUserGroup(ctx, *userGroup, &resp.Diagnostics)
+
 	plan.ID = types.StringValue(res.Data.ID)
 	plan.GroupType = types.StringValue(res.Data.GroupType)
 	plan.CreatedAt = types.StringValue(res.Data.CreatedTime)
 	plan.UpdatedAt = types.StringValue(res.Data.UpdatedTime)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UserGroupIdentity{GroupID: plan.GroupID})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -198,6 +330,9 @@ func (r *UserGroupResource) Create(ctx context.Context, req resource.CreateReque
 }
 
 func (r *UserGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
 	var state UserGroupConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -241,6 +376,7 @@ func (r *UserGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 	state.CustomFields = cf
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UserGroupIdentity{GroupID: state.GroupID})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -255,6 +391,9 @@ func (r *UserGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *UserGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
 	var plan, state UserGroupConfig
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -285,9 +424,11 @@ func (r *UserGroupResource) Update(ctx context.Context, req resource.UpdateReque
 	tflog.Info(ctx, "successfully updated user_group via API", util.H{
 		"group_id": state.GroupID.ValueString(),
 	})
+	r.waitForUserGroup(ctx, *userGroup, &resp.Diagnostics)
 
 	plan.GroupType = types.StringValue(res.Data.GroupType)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UserGroupIdentity{GroupID: plan.GroupID})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state after update", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -301,6 +442,9 @@ func (r *UserGroupResource) Update(ctx context.Context, req resource.UpdateReque
 }
 
 func (r *UserGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
 	var state UserGroupConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -344,7 +488,20 @@ func (r *UserGroupResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *UserGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("group_id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("group_id"), path.Root("group_id"), req, resp)
+}
+
+// waitForUserGroup re-reads the user group after a write until cidaas returns it with the written name,
+// description and parent.
+func (r *UserGroupResource) waitForUserGroup(ctx context.Context, group cidaas.UserGroupData, diags *diag.Diagnostics) {
+	waitForConsistency(ctx, "user group "+group.GroupID, func(ctx context.Context) (*cidaas.UserGroupResponse, error) {
+		return r.cidaasClient.UserGroup.Get(ctx, group.GroupID)
+	}, func(res *cidaas.UserGroupResponse) bool {
+		return res.Data.GroupID == group.GroupID &&
+			res.Data.GroupName == group.GroupName &&
+			res.Data.Description == group.Description &&
+			res.Data.ParentID == group.ParentID
+	}, diags)
 }
 
 func prepareUserGroupPayload(ctx context.Context, plan UserGroupConfig) (*cidaas.UserGroupData, diag.Diagnostics) {
@@ -365,3 +522,81 @@ func prepareUserGroupPayload(ctx context.Context, plan UserGroupConfig) (*cidaas
 	}
 	return &userGroup, nil
 }
+
+// validateGroupCustomFields checks the custom_fields of a user group against the group custom field definitions
+// applicable to the group's group_type. Group type specific checks are skipped while the group_type is unknown
+// and values are only checked once they are known.
+//
+// A key without definition is only a warning, because a cidaas_group_custom_field created in the same apply
+// is not returned by cidaas before it is applied.
+func validateGroupCustomFields(groupType types.String, customFields map[string]types.String, definitions []cidaas.GroupCustomFieldModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	groupTypeKnown := !groupType.IsUnknown() && !groupType.IsNull()
+
+	applicable := map[string]cidaas.GroupCustomFieldModel{}
+	defined := map[string]bool{}
+	for _, field := range definitions {
+		defined[field.FieldKey] = true
+		if len(field.GroupTypes) == 0 || !groupTypeKnown || util.Contains(field.GroupTypes, groupType.ValueString()) {
+			applicable[field.FieldKey] = field
+		}
+	}
+
+	keys := make([]string, 0, len(customFields))
+	for key := range customFields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		attrPath := path.Root("custom_fields").AtMapKey(key)
+		field, ok := applicable[key]
+		if !ok {
+			if defined[key] {
+				diags.AddAttributeError(attrPath, "Unexpected Resource Configuration",
+					fmt.Sprintf("The custom field %s is not applicable to user groups of group_type %s.", key, groupType.ValueString()))
+			} else {
+				diags.AddAttributeWarning(attrPath, "Undefined Group Custom Field",
+					fmt.Sprintf("The custom field %s is not defined in cidaas. This is expected if it is created with the %s resource"+
+						" in the same apply, otherwise define it before using it in custom_fields.", key, RESOURCE_GROUP_CUSTOM_FIELD))
+			}
+			continue
+		}
+		value := customFields[key]
+		if value.IsUnknown() || value.IsNull() {
+			continue
+		}
+		if err := validateGroupCustomFieldValue(field.DataType, value.ValueString()); err != nil {
+			diags.AddAttributeError(attrPath, "Unexpected Resource Configuration",
+				fmt.Sprintf("Invalid value for the custom field %s of data_type %s: %s", key, field.DataType, err.Error()))
+		}
+	}
+
+	if !groupTypeKnown {
+		return diags
+	}
+	for _, field := range definitions {
+		_, isApplicable := applicable[field.FieldKey]
+		if _, ok := customFields[field.FieldKey]; field.Required && isApplicable && !ok {
+			diags.AddAttributeError(path.Root("custom_fields"), "Unexpected Resource Configuration",
+				fmt.Sprintf("The custom field %s is required for user groups of group_type %s.", field.FieldKey, groupType.ValueString()))
+		}
+	}
+	return diags
+}
+
+func validateGroupCustomFieldValue(dataType, value string) error {
+	var err error
+	switch dataType {
+	case "NUMBER":
+		_, err = strconv.ParseFloat(value, 64)
+	case "BOOLEAN":
+		_, err = strconv.ParseBool(value)
+	case "DATE":
+		_, err = time.Parse(time.DateOnly, value)
+	}
+	if err != nil {
+		return fmt.Errorf("%q cannot be parsed as %s", value, dataType)
+	}
+	return nil
+}
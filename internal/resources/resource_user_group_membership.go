@@ -0,0 +1,379 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type UserGroupMembershipResource struct {
+	BaseResource
+}
+
+func NewUserGroupMembershipResource() resource.Resource {
+	return &UserGroupMembershipResource{
+		BaseResource: NewBaseResource(
+			BaseResourceConfig{
+				Name:   RESOURCE_USER_GROUP_MEMBERSHIP,
+				Schema: &userGroupMembershipSchema,
+			},
+		),
+	}
+}
+
+type UserGroupMembershipConfig struct {
+	ID        types.String   `tfsdk:"id"`
+	GroupID   types.String   `tfsdk:"group_id"`
+	Sub       types.String   `tfsdk:"sub"`
+	Roles     types.Set      `tfsdk:"roles"`
+	CreatedAt types.String   `tfsdk:"created_at"`
+	UpdatedAt types.String   `tfsdk:"updated_at"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+var userGroupMembershipSchema = schema.Schema{
+	MarkdownDescription: "The `cidaas_user_group_membership` resource assigns a user to a user group with a set of group roles." +
+		" The roles are validated against the `role_mode` and `allowed_roles` of the group's group type before they are applied." +
+		"\n\n Ensure that the below scopes are assigned to the client with the specified `client_id`:" +
+		"\n- cidaas:groups_read" +
+		"\n- cidaas:groups_write" +
+		"\n- cidaas:groups_delete" +
+		"\n- cidaas:group_type_read",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the membership in the format `group_id:sub`.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"group_id": schema.StringAttribute{
+			Required: true,
+			MarkdownDescription: "The group_id of the user group, for example the `group_id` of a `cidaas_user_group` resource." +
+				" It cannot be updated for an existing state.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				&validators.UniqueIdentifier{},
+			},
+		},
+		"sub": schema.StringAttribute{
+			Required: true,
+			MarkdownDescription: "The sub of the user, for example the `sub` of a `cidaas_user` resource." +
+				" It cannot be updated for an existing state.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				&validators.UniqueIdentifier{},
+			},
+		},
+		"roles": schema.SetAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			MarkdownDescription: "The group roles of the user. The roles must be part of the `allowed_roles` of the group's group type" +
+				" when its `role_mode` is `allowed_roles` or `roles_required`, and must be empty when the `role_mode` is `no_roles`.",
+			Validators: []validator.Set{
+				setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "The timestamp when the resource was created.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "The timestamp when the resource was last updated.",
+		},
+	},
+}
+
+type UserGroupMembershipIdentity struct {
+	GroupID types.String `tfsdk:"group_id"`
+	Sub     types.String `tfsdk:"sub"`
+}
+
+func (i UserGroupMembershipIdentity) importID() string {
+	return i.GroupID.ValueString() + ":" + i.Sub.ValueString()
+}
+
+func (r *UserGroupMembershipResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"group_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the user group.",
+			},
+			"sub": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The sub of the member.",
+			},
+		},
+	}
+}
+
+func (r *UserGroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
+	var plan UserGroupMembershipConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get plan data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	member, diags := r.prepareGroupMember(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to prepare group member payload", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	res, err := r.cidaasClient.UserGroup.AddMember(ctx, member)
+	if err != nil {
+		tflog.Error(ctx, "failed to add group member via API", util.H{
+			"group_id": member.GroupID,
+			"sub":      member.Sub,
+			"error":    err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to create user group membership", util.FormatErrorMessage(err))
+		return
+	}
+	tflog.Info(ctx, "successfully added group member via API", util.H{
+		"group_id": member.GroupID,
+		"sub":      member.Sub,
+	})
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", member.GroupID, member.Sub))
+	plan.CreatedAt = util.StringValueOrNull(&res.Data.CreatedTime)
+	plan.UpdatedAt = util.StringValueOrNull(&res.Data.UpdatedTime)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UserGroupMembershipIdentity{GroupID: plan.GroupID, Sub: plan.Sub})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "resource user_group_membership created successfully", util.H{
+		"id": plan.ID.ValueString(),
+	})
+}
+
+func (r *UserGroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
+	var state UserGroupMembershipConfig
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get state data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	res, err := r.cidaasClient.UserGroup.GetMember(ctx, state.GroupID.ValueString(), state.Sub.ValueString())
+	if err != nil {
+		tflog.Error(ctx, "failed to read group member via API", util.H{
+			"group_id": state.GroupID.ValueString(),
+			"sub":      state.Sub.ValueString(),
+			"error":    err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to read user group membership", util.FormatErrorMessage(err))
+		return
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("%s:%s", state.GroupID.ValueString(), state.Sub.ValueString()))
+	state.Roles = util.SetValueOrNull(res.Data.Roles)
+	state.CreatedAt = util.StringValueOrNull(&res.Data.CreatedTime)
+	state.UpdatedAt = util.StringValueOrNull(&res.Data.UpdatedTime)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UserGroupMembershipIdentity{GroupID: state.GroupID, Sub: state.Sub})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Debug(ctx, "resource user_group_membership read successfully", util.H{
+		"id": state.ID.ValueString(),
+	})
+}
+
+func (r *UserGroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
+	var plan, state UserGroupMembershipConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get plan or state data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	member, diags := r.prepareGroupMember(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to prepare group member payload for update", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	res, err := r.cidaasClient.UserGroup.UpdateMember(ctx, member)
+	if err != nil {
+		tflog.Error(ctx, "failed to update group member via API", util.H{
+			"id":    state.ID.ValueString(),
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to update user group membership", util.FormatErrorMessage(err))
+		return
+	}
+	tflog.Info(ctx, "successfully updated group member via API", util.H{
+		"id": state.ID.ValueString(),
+	})
+
+	plan.UpdatedAt = util.StringValueOrNull(&res.Data.UpdatedTime)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UserGroupMembershipIdentity{GroupID: plan.GroupID, Sub: plan.Sub})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state after update", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Debug(ctx, "resource user_group_membership updated successfully", util.H{
+		"id": state.ID.ValueString(),
+	})
+}
+
+func (r *UserGroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
+	var state UserGroupMembershipConfig
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get state data for deletion", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	err := r.cidaasClient.UserGroup.RemoveMember(ctx, state.GroupID.ValueString(), state.Sub.ValueString())
+	if err != nil {
+		tflog.Error(ctx, "failed to remove group member via API", util.H{
+			"id":    state.ID.ValueString(),
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to delete user group membership", util.FormatErrorMessage(err))
+		return
+	}
+
+	tflog.Info(ctx, "resource user_group_membership deleted successfully", util.H{
+		"id": state.ID.ValueString(),
+	})
+}
+
+func (r *UserGroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := importID[UserGroupMembershipIdentity](ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: 'group_id:sub', got: %s", id),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sub"), parts[1])...)
+}
+
+// prepareGroupMember builds the member payload and validates the roles against the group type of the group.
+func (r *UserGroupMembershipResource) prepareGroupMember(ctx context.Context, plan UserGroupMembershipConfig) (cidaas.GroupMemberData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	member := cidaas.GroupMemberData{
+		GroupID: plan.GroupID.ValueString(),
+		Sub:     plan.Sub.ValueString(),
+		Roles:   []string{},
+	}
+	diags.Append(plan.Roles.ElementsAs(ctx, &member.Roles, false)...)
+	if diags.HasError() {
+		return member, diags
+	}
+
+	group, err := r.cidaasClient.UserGroup.Get(ctx, member.GroupID)
+	if err != nil {
+		diags.AddError("failed to read user group", util.FormatErrorMessage(err))
+		return member, diags
+	}
+	groupType, err := r.cidaasClient.GroupType.Get(ctx, group.Data.GroupType)
+	if err != nil {
+		diags.AddError("failed to read group type", util.FormatErrorMessage(err))
+		return member, diags
+	}
+	diags.Append(validateGroupMemberRoles(member.Roles, groupType.Data)...)
+	return member, diags
+}
+
+// validateGroupMemberRoles checks the roles of a group member against the role_mode and allowed_roles of the group type.
+func validateGroupMemberRoles(roles []string, groupType cidaas.GroupTypeData) diag.Diagnostics {
+	var diags diag.Diagnostics
+	switch groupType.RoleMode {
+	case "no_roles":
+		if len(roles) > 0 {
+			diags.AddAttributeError(path.Root("roles"), "Invalid Attribute Configuration",
+				fmt.Sprintf("The group type %s does not allow roles, got %v.", groupType.GroupType, roles))
+		}
+	case "roles_required", "allowed_roles":
+		if groupType.RoleMode == "roles_required" && len(roles) == 0 {
+			diags.AddAttributeError(path.Root("roles"), "Missing Attribute Configuration",
+				fmt.Sprintf("The group type %s requires at least one role. Allowed roles are %v.", groupType.GroupType, groupType.AllowedRoles))
+		}
+		for _, role := range roles {
+			if !util.Contains(groupType.AllowedRoles, role) {
+				diags.AddAttributeError(path.Root("roles"), "Invalid Attribute Configuration",
+					fmt.Sprintf("The role %s is not allowed for the group type %s. Allowed roles are %v.", role, groupType.GroupType, groupType.AllowedRoles))
+			}
+		}
+	}
+	return diags
+}
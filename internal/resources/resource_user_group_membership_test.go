@@ -0,0 +1,98 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	acctest "github.com/Cidaas/terraform-provider-cidaas/internal/test"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// create, read, import and update test
+func TestAccUserGroupMembershipResource_Basic(t *testing.T) {
+	t.Parallel()
+
+	testResourceID := acctest.RandString(10)
+	groupID := acctest.RandString(10)
+	testResourceName := fmt.Sprintf("%s.%s", resources.RESOURCE_USER_GROUP_MEMBERSHIP, testResourceID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserGroupMembershipResourceConfig(testResourceID, groupID, `["ADMIN"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "group_id", groupID),
+					resource.TestCheckResourceAttr(testResourceName, "roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr(testResourceName, "roles.*", "ADMIN"),
+					resource.TestCheckResourceAttrSet(testResourceName, "sub"),
+				),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccUserGroupMembershipImportStateIDFunc(testResourceName),
+				ImportStateVerifyIgnore: []string{"created_at", "updated_at"},
+			},
+			{
+				Config: testAccUserGroupMembershipResourceConfig(testResourceID, groupID, `["ADMIN", "MEMBER"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "roles.#", "2"),
+				),
+			},
+			{
+				Config:      testAccUserGroupMembershipResourceConfig(testResourceID, groupID, `["DEVELOPER"]`),
+				ExpectError: regexp.MustCompile(`The role DEVELOPER is not allowed for the group type`),
+			},
+		},
+	})
+}
+
+func testAccUserGroupMembershipImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found", resourceName)
+		}
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["group_id"], rs.Primary.Attributes["sub"]), nil
+	}
+}
+
+func testAccUserGroupMembershipResourceConfig(resourceID, groupID, roles string) string {
+	return fmt.Sprintf(`
+		provider "cidaas" {
+			base_url = "%s"
+		}
+		resource "cidaas_group_type" "%s" {
+			group_type    = "%s"
+			role_mode     = "allowed_roles"
+			description   = "group type description"
+			allowed_roles = ["ADMIN", "MEMBER"]
+		}
+		resource "cidaas_user_group" "%s" {
+			group_type = cidaas_group_type.%s.group_type
+			group_id   = "%s"
+			group_name = "%s"
+		}
+		resource "cidaas_user" "%s" {
+			email            = "%s@example.com"
+			initial_password = "Terraform@123"
+		}
+		resource "cidaas_user_group_membership" "%s" {
+			group_id = cidaas_user_group.%s.group_id
+			sub      = cidaas_user.%s.sub
+			roles    = %s
+		}
+	`, acctest.GetBaseURL(), resourceID, strings.ToLower(resourceID), resourceID, resourceID, groupID, groupID,
+		resourceID, strings.ToLower(resourceID), resourceID, resourceID, resourceID, roles)
+}
@@ -2,18 +2,30 @@ package resources_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
 	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
 	acctest "github.com/Cidaas/terraform-provider-cidaas/internal/test"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 // create, read and update test
@@ -82,7 +94,7 @@ func testAccUserGroupResourceConfig(groupType, groupID, groupDescription, resour
 			role_mode   = "no_roles"
 			description = "group type description"
 		}
-		resource "cidaas_user_groups" "%s" {
+		resource "cidaas_user_group" "%s" {
 			group_type                     = cidaas_group_type.%s.group_type
 			group_id                       = "`+groupID+`"
 			group_name                     = "`+groupName+`"
@@ -209,7 +221,7 @@ func TestUserGroup_MissingRequired(t *testing.T) {
                         provider "cidaas" {
                             base_url = "%s"
                         }
-                        resource "cidaas_user_groups" "%s" {}
+                        resource "cidaas_user_group" "%s" {}
                     `, acctest.GetBaseURL(), testResourceID),
 						ExpectError: regexp.MustCompile(fmt.Sprintf(`The argument "%s" is required`, v)),
 					},
@@ -234,7 +246,7 @@ func TestUserGroup_CheckEmptyString(t *testing.T) {
 					provider "cidaas" {
 						base_url = "%s"
 					}
-					resource "cidaas_user_groups" "%s" {
+					resource "cidaas_user_group" "%s" {
 						group_type  =""
 						group_id    = ""
 						group_name  = ""
@@ -278,3 +290,172 @@ func TestUserGroup_GroupIDIsImmutable(t *testing.T) {
 		},
 	})
 }
+
+// the state of the deprecated cidaas_user_groups is moved to cidaas_user_group unchanged
+func TestUserGroup_MoveState(t *testing.T) {
+	server, schemas := providerSchemas(t)
+	if !schemas[resources.RESOURCE_USER_GROUPS].Block.Deprecated {
+		t.Errorf("Expected %s to be deprecated", resources.RESOURCE_USER_GROUPS)
+	}
+
+	source := `{"id":"id","group_type":"department","group_id":"team","group_name":"Team","parent_id":"root",` +
+		`"logo_url":null,"description":"sample","make_first_user_admin":false,"member_profile_visibility":"public",` +
+		`"none_member_profile_visibility":"none","custom_fields":{"region":"eu"},"created_at":"2024-01-01T00:00:00Z",` +
+		`"updated_at":"2024-01-02T00:00:00Z","timeouts":null}`
+	resp, err := server.MoveResourceState(context.Background(), &tfprotov6.MoveResourceStateRequest{
+		SourceProviderAddress: "registry.terraform.io/cidaas/cidaas",
+		SourceTypeName:        resources.RESOURCE_USER_GROUPS,
+		SourceSchemaVersion:   0,
+		SourceState:           &tfprotov6.RawState{JSON: []byte(source)},
+		TargetTypeName:        resources.RESOURCE_USER_GROUP,
+	})
+	if err != nil {
+		t.Fatalf("MoveResourceState failed: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Fatalf("Unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	state, err := resp.TargetState.Unmarshal(schemas[resources.RESOURCE_USER_GROUP].ValueType())
+	if err != nil {
+		t.Fatalf("Failed to read the moved state: %v", err)
+	}
+	moved, _ := json.Marshal(stateJSON(state))
+	expected := map[string]any{}
+	_ = json.Unmarshal([]byte(source), &expected)
+	if want, _ := json.Marshal(expected); string(moved) != string(want) {
+		t.Errorf("Expected the state to be moved unchanged, got %s", moved)
+	}
+	identity, err := resp.TargetIdentity.IdentityData.Unmarshal(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"group_id": tftypes.String}})
+	if err != nil || !identity.Equal(tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"group_id": tftypes.String}},
+		map[string]tftypes.Value{"group_id": tftypes.NewValue(tftypes.String, "team")})) {
+		t.Errorf("Expected the identity of the group team, got %v %v", identity, err)
+	}
+
+	// other resource types are not moved
+	resp, err = server.MoveResourceState(context.Background(), &tfprotov6.MoveResourceStateRequest{
+		SourceProviderAddress: "registry.terraform.io/cidaas/cidaas",
+		SourceTypeName:        resources.RESOURCE_GROUP_TYPE,
+		SourceState:           &tfprotov6.RawState{JSON: []byte(`{"group_type":"department"}`)},
+		TargetTypeName:        resources.RESOURCE_USER_GROUP,
+	})
+	if err != nil || len(resp.Diagnostics) == 0 {
+		t.Errorf("Expected an error for moving %s, got %v", resources.RESOURCE_GROUP_TYPE, err)
+	}
+}
+
+// a cidaas_user_groups resource is moved to cidaas_user_group without recreating the group
+func TestUserGroup_MovedFromUserGroups(t *testing.T) {
+	t.Parallel()
+
+	groupType := acctest.RandString(10)
+	groupID := acctest.RandString(10)
+	groupName := acctest.RandString(10)
+	testResourceID := acctest.RandString(10)
+	testResourceName := fmt.Sprintf("%s.%s", resources.RESOURCE_USER_GROUP, testResourceID)
+	legacyConfig := strings.Replace(testAccUserGroupResourceConfig(groupType, groupID, "moved", testResourceID, groupName),
+		`resource "cidaas_user_group"`, `resource "cidaas_user_groups"`, 1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		CheckDestroy: testCheckUserGroupDestroyed(testResourceID),
+		Steps: []resource.TestStep{
+			{
+				Config: legacyConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("%s.%s", resources.RESOURCE_USER_GROUPS, testResourceID), "group_id", groupID),
+				),
+			},
+			{
+				Config: testAccUserGroupResourceConfig(groupType, groupID, "moved", testResourceID, groupName) + fmt.Sprintf(`
+					moved {
+						from = cidaas_user_groups.%s
+						to   = cidaas_user_group.%s
+					}
+				`, testResourceID, testResourceID),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testResourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "group_id", groupID),
+				),
+			},
+		},
+	})
+}
+
+// custom_fields with unknown values and keys of fields created in the same apply pass the plan
+func TestUserGroup_ModifyPlanCustomFields(t *testing.T) {
+	ctx := context.Background()
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"data":[{"fieldKey":"headcount","dataType":"NUMBER"}]}`))
+	}))
+	defer server.Close()
+
+	r := resources.NewUserGroupResource()
+	client := &cidaas.Client{GroupFields: cidaas.NewGroupCustomField(cidaas.ClientConfig{BaseURL: server.URL, AccessToken: "test-token"})}
+	r.(fwresource.ResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{ProviderData: client}, &fwresource.ConfigureResponse{})
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	modifyPlan := func(customFields types.Map) fwresource.ModifyPlanResponse {
+		t.Helper()
+		plan := tfsdk.Plan{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}
+		diags := plan.Set(ctx, resources.UserGroupConfig{
+			ID:                          types.StringUnknown(),
+			GroupType:                   types.StringValue("department"),
+			GroupID:                     types.StringValue("team"),
+			GroupName:                   types.StringValue("Team"),
+			ParentID:                    types.StringValue("root"),
+			LogoURL:                     types.StringNull(),
+			Description:                 types.StringNull(),
+			MakeFirstUserAdmin:          types.BoolValue(false),
+			MemberProfileVisibility:     types.StringValue("public"),
+			NoneMemberProfileVisibility: types.StringValue("none"),
+			CustomFields:                customFields,
+			CreatedAt:                   types.StringUnknown(),
+			UpdatedAt:                   types.StringUnknown(),
+			Timeouts:                    nullTimeouts(),
+		})
+		if diags.HasError() {
+			t.Fatalf("Failed to set plan: %v", diags)
+		}
+		resp := fwresource.ModifyPlanResponse{Plan: plan}
+		r.(fwresource.ResourceWithModifyPlan).ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan}, &resp)
+		return resp
+	}
+
+	resp := modifyPlan(types.MapNull(types.StringType))
+	if resp.Diagnostics.HasError() || requests.Load() != 0 {
+		t.Errorf("Expected no validation without custom_fields, got %d requests and %v", requests.Load(), resp.Diagnostics)
+	}
+
+	resp = modifyPlan(types.MapValueMust(types.StringType, map[string]attr.Value{
+		"headcount":   types.StringUnknown(),
+		"cost_center": types.StringValue("CC-1234"),
+	}))
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Expected no errors, got %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 || !strings.Contains(resp.Diagnostics.Warnings()[0].Detail(), "cost_center") {
+		t.Errorf("Expected a warning for the undefined field cost_center, got %v", resp.Diagnostics)
+	}
+
+	resp = modifyPlan(types.MapValueMust(types.StringType, map[string]attr.Value{
+		"headcount": types.StringValue("many"),
+	}))
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected an error for a known value which is not a number")
+	}
+}
@@ -0,0 +1,266 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type UserRoleResource struct {
+	BaseResource
+}
+
+func NewUserRoleResource() resource.Resource {
+	return &UserRoleResource{
+		BaseResource: NewBaseResource(
+			BaseResourceConfig{
+				Name:   RESOURCE_USER_ROLE,
+				Schema: &userRoleSchema,
+			},
+		),
+	}
+}
+
+type UserRoleConfig struct {
+	ID       types.String   `tfsdk:"id"`
+	Role     types.String   `tfsdk:"role"`
+	Sub      types.String   `tfsdk:"sub"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+var userRoleSchema = schema.Schema{
+	MarkdownDescription: "The `cidaas_user_role` resource assigns a role to a single user." +
+		" It is non-authoritative, other users of the role are not affected. Use the `cidaas_role_members` resource to manage" +
+		" the complete list of users of a role instead. The two resources must not be used for the same role." +
+		"\n\n If the role is unassigned from the user outside of Terraform, the assignment is recreated on the next apply." +
+		"\n\n Ensure that the below scopes are assigned to the client with the specified `client_id`:" +
+		"\n- cidaas:roles_read" +
+		"\n- cidaas:roles_write" +
+		"\n- cidaas:roles_delete",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the assignment in the format `role:sub`.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"role": schema.StringAttribute{
+			Required: true,
+			MarkdownDescription: "The role to assign, for example the `role` of a `cidaas_role` resource." +
+				" It cannot be updated for an existing state.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				&validators.UniqueIdentifier{},
+			},
+		},
+		"sub": schema.StringAttribute{
+			Required: true,
+			MarkdownDescription: "The sub of the user, for example the `sub` of a `cidaas_user` resource." +
+				" It cannot be updated for an existing state.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				&validators.UniqueIdentifier{},
+			},
+		},
+	},
+}
+
+type UserRoleIdentity struct {
+	Role types.String `tfsdk:"role"`
+	Sub  types.String `tfsdk:"sub"`
+}
+
+func (i UserRoleIdentity) importID() string {
+	return i.Role.ValueString() + ":" + i.Sub.ValueString()
+}
+
+func (r *UserRoleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"role": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The role assigned to the user.",
+			},
+			"sub": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The sub of the user.",
+			},
+		},
+	}
+}
+
+func (r *UserRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
+	var plan UserRoleConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get plan data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	userRole := cidaas.UserRoleModel{
+		Role: plan.Role.ValueString(),
+		Sub:  plan.Sub.ValueString(),
+	}
+	_, err := r.cidaasClient.Roles.AssignUser(ctx, userRole)
+	if err != nil {
+		tflog.Error(ctx, "failed to assign role via API", util.H{
+			"role":  userRole.Role,
+			"sub":   userRole.Sub,
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to create user role", util.FormatErrorMessage(err))
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", userRole.Role, userRole.Sub))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UserRoleIdentity{Role: plan.Role, Sub: plan.Sub})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "resource user_role created successfully", util.H{
+		"id": plan.ID.ValueString(),
+	})
+}
+
+func (r *UserRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
+	var state UserRoleConfig
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get state data", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	members, err := r.cidaasClient.Roles.GetMembers(ctx, state.Role.ValueString())
+	if err != nil {
+		tflog.Error(ctx, "failed to read role members via API", util.H{
+			"role":  state.Role.ValueString(),
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to read user role", util.FormatErrorMessage(err))
+		return
+	}
+
+	// the assignment was removed outside of terraform, removing it from the state plans its recreation
+	if !util.Contains(members, state.Sub.ValueString()) {
+		tflog.Warn(ctx, "role is no longer assigned to the user, removing resource from state", util.H{
+			"role": state.Role.ValueString(),
+			"sub":  state.Sub.ValueString(),
+		})
+		// terraform requires the identity of a resource state which did not have one yet even if it is removed
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, UserRoleIdentity{Role: state.Role, Sub: state.Sub})...)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("%s:%s", state.Role.ValueString(), state.Sub.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UserRoleIdentity{Role: state.Role, Sub: state.Sub})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to set state", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	tflog.Debug(ctx, "resource user_role read successfully", util.H{
+		"id": state.ID.ValueString(),
+	})
+}
+
+// Update is never called with a change as all configurable attributes are immutable.
+func (r *UserRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
+	var plan UserRoleConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UserRoleIdentity{Role: plan.Role, Sub: plan.Sub})...)
+}
+
+func (r *UserRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
+	var state UserRoleConfig
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "failed to get state data for deletion", util.H{
+			"errors": resp.Diagnostics.Errors(),
+		})
+		return
+	}
+
+	err := r.cidaasClient.Roles.UnassignUser(ctx, cidaas.UserRoleModel{
+		Role: state.Role.ValueString(),
+		Sub:  state.Sub.ValueString(),
+	})
+	if err != nil {
+		tflog.Error(ctx, "failed to unassign role via API", util.H{
+			"id":    state.ID.ValueString(),
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError("failed to delete user role", util.FormatErrorMessage(err))
+		return
+	}
+
+	tflog.Info(ctx, "resource user_role deleted successfully", util.H{
+		"id": state.ID.ValueString(),
+	})
+}
+
+func (r *UserRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := importID[UserRoleIdentity](ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// the sub is a uuid, splitting at the last colon keeps roles containing a colon intact
+	idx := strings.LastIndex(id, ":")
+	if idx <= 0 || idx == len(id)-1 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: 'role:sub', got: %s", id),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), id[:idx])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sub"), id[idx+1:])...)
+}
@@ -0,0 +1,132 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	acctest "github.com/Cidaas/terraform-provider-cidaas/internal/test"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// create, read and import test
+func TestAccUserRoleResource_Basic(t *testing.T) {
+	t.Parallel()
+
+	testResourceID := acctest.RandString(10)
+	role := strings.ToUpper(acctest.RandString(10))
+	testResourceName := fmt.Sprintf("%s.%s", resources.RESOURCE_USER_ROLE, testResourceID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleAndUserConfig(testResourceID, role) + fmt.Sprintf(`
+				resource "cidaas_user_role" "%s" {
+					role = cidaas_role.%s.role
+					sub  = cidaas_user.%s.sub
+				}
+				`, testResourceID, testResourceID, testResourceID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "role", role),
+					resource.TestCheckResourceAttrSet(testResourceName, "sub"),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[testResourceName]
+					if !ok {
+						return "", fmt.Errorf("resource %s not found", testResourceName)
+					}
+					return rs.Primary.ID, nil
+				},
+			},
+		},
+	})
+}
+
+// import with an import block by the resource identity
+func TestAccUserRoleResource_importByIdentity(t *testing.T) {
+	t.Parallel()
+
+	testResourceID := acctest.RandString(10)
+	role := strings.ToUpper(acctest.RandString(10))
+	testResourceName := fmt.Sprintf("%s.%s", resources.RESOURCE_USER_ROLE, testResourceID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleAndUserConfig(testResourceID, role) + fmt.Sprintf(`
+				resource "cidaas_user_role" "%s" {
+					role = cidaas_role.%s.role
+					sub  = cidaas_user.%s.sub
+				}
+				`, testResourceID, testResourceID, testResourceID),
+			},
+			{
+				ResourceName:    testResourceName,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func testAccRoleAndUserConfig(resourceID, role string) string {
+	return fmt.Sprintf(`
+		provider "cidaas" {
+			base_url = "%s"
+		}
+		resource "cidaas_role" "%s" {
+			role = "%s"
+			name = "%s"
+		}
+		resource "cidaas_user" "%s" {
+			email            = "%s@example.com"
+			initial_password = "Terraform@123"
+		}
+	`, acctest.GetBaseURL(), resourceID, role, role, resourceID, strings.ToLower(resourceID))
+}
+
+// import identifier must be in the format role:sub
+func TestAccUserRoleResource_InvalidImportID(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				provider "cidaas" {
+					base_url = "%s"
+				}
+				resource "cidaas_user_role" "sample" {
+					role = "ADMIN"
+					sub  = "sub"
+				}
+				`, acctest.GetBaseURL()),
+				ResourceName:  "cidaas_user_role.sample",
+				ImportState:   true,
+				ImportStateId: "ADMIN",
+				ExpectError:   regexp.MustCompile(`Expected import identifier with format: 'role:sub'`),
+			},
+		},
+	})
+}
@@ -0,0 +1,200 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/resources"
+	acctest "github.com/Cidaas/terraform-provider-cidaas/internal/test"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// create, read, import and update test
+func TestAccUserResource_Basic(t *testing.T) {
+	t.Parallel()
+
+	email := fmt.Sprintf("%s@example.com", strings.ToLower(acctest.RandString(10)))
+	testResourceID := acctest.RandString(10)
+	testResourceName := fmt.Sprintf("%s.%s", resources.RESOURCE_USER, testResourceID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		// write-only attributes are supported from Terraform 1.11 onwards
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testCheckUserDestroyed(testResourceName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserResourceConfig(testResourceID, email, "Jane"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "email", email),
+					resource.TestCheckResourceAttr(testResourceName, "given_name", "Jane"),
+					resource.TestCheckNoResourceAttr(testResourceName, "initial_password"),
+					resource.TestCheckResourceAttrSet(testResourceName, "sub"),
+					resource.TestCheckResourceAttrPair(testResourceName, "id", testResourceName, "sub"),
+				),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccUserImportStateIDFunc(testResourceName),
+				ImportStateVerifyIgnore: []string{"created_at", "updated_at"},
+			},
+			{
+				Config: testAccUserResourceConfig(testResourceID, email, "John"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "given_name", "John"),
+				),
+			},
+		},
+	})
+}
+
+// the configured status is kept in the state even if cidaas returns another status for the created user
+func TestUserResource_CreateConfiguredStatus(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"data":{"sub":"user-sub","user_status":"VERIFIED"}}`))
+	}))
+	defer server.Close()
+
+	r := resources.NewUserResource()
+	client := &cidaas.Client{Users: cidaas.NewUser(cidaas.ClientConfig{BaseURL: server.URL, AccessToken: "test-token"})}
+	configureResp := fwresource.ConfigureResponse{}
+	r.(fwresource.ResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{ProviderData: client}, &configureResp)
+
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := plan.Set(ctx, resources.UserConfig{
+		ID:              types.StringUnknown(),
+		Sub:             types.StringUnknown(),
+		Email:           types.StringValue("user@example.com"),
+		MobileNumber:    types.StringNull(),
+		GivenName:       types.StringValue("Test"),
+		FamilyName:      types.StringNull(),
+		CustomFields:    types.MapNull(types.StringType),
+		InitialPassword: types.StringNull(),
+		Status:          types.StringValue("PENDING"),
+		CreatedAt:       types.StringUnknown(),
+		UpdatedAt:       types.StringUnknown(),
+		Timeouts:        nullTimeouts(),
+	})
+	if diags.HasError() {
+		t.Fatalf("Failed to set plan: %v", diags)
+	}
+
+	identitySchema := fwresource.IdentitySchemaResponse{}
+	r.(fwresource.ResourceWithIdentity).IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, &identitySchema)
+	resp := fwresource.CreateResponse{
+		State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw.Copy()},
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: identitySchema.IdentitySchema,
+			Raw:    tftypes.NewValue(identitySchema.IdentitySchema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan, Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw.Copy()}}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics)
+	}
+
+	var status types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("status"), &status)...)
+	if status.ValueString() != "PENDING" {
+		t.Errorf("Expected the configured status PENDING, got %s", status)
+	}
+}
+
+func testAccUserResourceConfig(resourceID, email, givenName string) string {
+	return fmt.Sprintf(`
+		provider "cidaas" {
+			base_url = "%s"
+		}
+		resource "cidaas_user" "%s" {
+			email            = "%s"
+			given_name       = "%s"
+			family_name      = "Doe"
+			initial_password = "Terraform@123"
+		}
+	`, acctest.GetBaseURL(), resourceID, email, givenName)
+}
+
+func testAccUserImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found", resourceName)
+		}
+		return rs.Primary.Attributes["sub"], nil
+	}
+}
+
+func testCheckUserDestroyed(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+
+		user := cidaas.User{
+			ClientConfig: cidaas.ClientConfig{
+				BaseURL:     acctest.GetBaseURL(),
+				AccessToken: acctest.TestToken,
+			},
+		}
+		res, err := user.Get(context.Background(), rs.Primary.Attributes["sub"])
+		if err != nil {
+			if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") {
+				return nil
+			}
+			return fmt.Errorf("error checking if user exists: %w", err)
+		}
+		if res != nil && res.Data.Sub != "" {
+			return fmt.Errorf("user %s still exists", res.Data.Sub)
+		}
+		return nil
+	}
+}
+
+// either email or mobile_number must be configured
+func TestAccUserResource_MissingIdentifier(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				provider "cidaas" {
+					base_url = "%s"
+				}
+				resource "cidaas_user" "sample" {
+					given_name = "Jane"
+				}
+				`, acctest.GetBaseURL()),
+				ExpectError: regexp.MustCompile(`At least one attribute out of \[mobile_number,email\] must be specified`),
+			},
+		},
+	})
+}
@@ -7,12 +7,14 @@ import (
 
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -52,6 +54,7 @@ type WebhookConfig struct {
 	apiKeyConfig     *AuthConfig
 	totpConfig       *AuthConfig
 	cidaasAuthConfig *CidaasAuthConfig
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 type AuthConfig struct {
@@ -220,7 +223,25 @@ var webhookSchema = schema.Schema{
 	},
 }
 
+type WebhookIdentity struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *WebhookResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique identifier of the webhook.",
+			},
+		},
+	}
+}
+
 func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { //nolint:dupl
+	ctx, done := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer done()
+
 	var plan WebhookConfig
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -249,6 +270,7 @@ func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest
 	plan.CreatedAt = util.StringValueOrNull(&res.Data.CreatedTime)
 	plan.UpdatedAt = util.StringValueOrNull(&res.Data.UpdatedTime)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, WebhookIdentity{ID: plan.ID})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -262,6 +284,9 @@ func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest
 }
 
 func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer done()
+
 	var state WebhookConfig
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -346,6 +371,7 @@ func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, WebhookIdentity{ID: state.ID})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "failed to set state", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -359,6 +385,9 @@ func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, done := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer done()
+
 	tflog.Debug(ctx, "Starting webhook update")
 
 	var plan, state WebhookConfig
@@ -395,6 +424,7 @@ func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, WebhookIdentity{ID: plan.ID})...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "Failed to set state after update", util.H{
 			"errors": resp.Diagnostics.Errors(),
@@ -408,6 +438,9 @@ func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer done()
+
 	tflog.Debug(ctx, "Starting webhook deletion")
 
 	var state WebhookConfig
@@ -438,7 +471,7 @@ func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *WebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 var _ planmodifier.String = configVerifier{}
@@ -0,0 +1,155 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"math/big"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	provider "github.com/Cidaas/terraform-provider-cidaas/internal"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var update = flag.Bool("update", false, "update the golden files of the state fixtures")
+
+// fixtureName is the name of a state fixture, <resource>_v<version>[_<description>].json.
+var fixtureName = regexp.MustCompile(`^(.+?)_v(\d+)(?:_(.+))?\.json$`)
+
+func providerSchemas(t *testing.T) (tfprotov6.ProviderServer, map[string]*tfprotov6.Schema) {
+	t.Helper()
+	server, err := providerserver.NewProtocol6WithError(provider.Cidaas("test")())()
+	if err != nil {
+		t.Fatalf("Failed to create provider server: %v", err)
+	}
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema failed: %v", err)
+	}
+	return server, resp.ResourceSchemas
+}
+
+// TestUpgradeState_Fixtures upgrades the states in testdata/state, which were written by prior versions
+// of the provider, and compares the upgraded states with their golden files.
+// Run the test with -update to write the golden files of new fixtures.
+func TestUpgradeState_Fixtures(t *testing.T) {
+	server, schemas := providerSchemas(t)
+	fixtures, err := filepath.Glob(filepath.Join("testdata", "state", "*.json"))
+	if err != nil {
+		t.Fatalf("Failed to list state fixtures: %v", err)
+	}
+
+	for _, fixture := range fixtures {
+		if strings.HasSuffix(fixture, ".golden.json") {
+			continue
+		}
+		t.Run(filepath.Base(fixture), func(t *testing.T) {
+			match := fixtureName.FindStringSubmatch(filepath.Base(fixture))
+			if match == nil {
+				t.Fatalf("Invalid fixture name %s, expected <resource>_v<version>[_<description>].json", fixture)
+			}
+			typeName := match[1]
+			version, _ := strconv.ParseInt(match[2], 10, 64)
+			s, ok := schemas[typeName]
+			if !ok {
+				t.Fatalf("Unknown resource %s", typeName)
+			}
+
+			content, err := os.ReadFile(fixture)
+			if err != nil {
+				t.Fatalf("Failed to read fixture: %v", err)
+			}
+			resp, err := server.UpgradeResourceState(context.Background(), &tfprotov6.UpgradeResourceStateRequest{
+				TypeName: typeName,
+				Version:  version,
+				RawState: &tfprotov6.RawState{JSON: content},
+			})
+			if err != nil {
+				t.Fatalf("UpgradeResourceState failed: %v", err)
+			}
+			for _, d := range resp.Diagnostics {
+				t.Fatalf("Unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+			}
+			state, err := resp.UpgradedState.Unmarshal(s.ValueType())
+			if err != nil {
+				t.Fatalf("Failed to read the upgraded state: %v", err)
+			}
+			upgraded, err := json.MarshalIndent(stateJSON(state), "", "  ")
+			if err != nil {
+				t.Fatalf("Failed to marshal the upgraded state: %v", err)
+			}
+			upgraded = append(upgraded, '\n')
+
+			golden := strings.TrimSuffix(fixture, ".json") + ".golden.json"
+			if *update {
+				if err = os.WriteFile(golden, upgraded, 0o600); err != nil {
+					t.Fatalf("Failed to write golden file: %v", err)
+				}
+			}
+			expected, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("Failed to read golden file, run the test with -update to create it: %v", err)
+			}
+			if string(expected) != string(upgraded) {
+				t.Errorf("The upgraded state does not match %s:\n%s", golden, upgraded)
+			}
+		})
+	}
+}
+
+// TestUpgradeState_Versions ensures that the state of every prior schema version of a resource is covered by a fixture.
+func TestUpgradeState_Versions(t *testing.T) {
+	_, schemas := providerSchemas(t)
+	for typeName, s := range schemas {
+		for version := range s.Version {
+			fixtures, _ := filepath.Glob(filepath.Join("testdata", "state", typeName+"_v"+strconv.FormatInt(version, 10)+"*.json"))
+			if len(fixtures) == 0 {
+				t.Errorf("Expected a state fixture of version %d of %s", version, typeName)
+			}
+		}
+	}
+}
+
+// stateJSON converts a state value to the values of its JSON representation.
+func stateJSON(v tftypes.Value) any {
+	if v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+	switch {
+	case v.Type().Is(tftypes.String):
+		var s string
+		_ = v.As(&s)
+		return s
+	case v.Type().Is(tftypes.Bool):
+		var b bool
+		_ = v.As(&b)
+		return b
+	case v.Type().Is(tftypes.Number):
+		n := new(big.Float)
+		_ = v.As(&n)
+		return json.Number(n.Text('f', -1))
+	case v.Type().Is(tftypes.List{}), v.Type().Is(tftypes.Set{}), v.Type().Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		_ = v.As(&elements)
+		values := make([]any, 0, len(elements))
+		for _, element := range elements {
+			values = append(values, stateJSON(element))
+		}
+		return values
+	default:
+		var attributes map[string]tftypes.Value
+		_ = v.As(&attributes)
+		values := make(map[string]any, len(attributes))
+		for name, attribute := range attributes {
+			values[name] = stateJSON(attribute)
+		}
+		return values
+	}
+}
@@ -2,16 +2,25 @@ package acctest
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"hash/fnv"
+	"io/fs"
 	"math/rand"
 	"net/http"
 	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
 	"github.com/Cidaas/terraform-provider-cidaas/helpers/util"
 	provider "github.com/Cidaas/terraform-provider-cidaas/internal"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/test/recorder"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/test/simulator"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
@@ -24,16 +33,58 @@ var TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"cidaas": providerserver.NewProtocol6WithError(provider.Cidaas("test")()),
 }
 
+// CassetteModeEnv selects whether the requests of the acceptance tests are recorded to or replayed from
+// testdata/fixtures/<TestName>.yaml. The mode is record or replay, the requests are sent as usual if it is not set.
+const CassetteModeEnv = "TERRAFORM_PROVIDER_CIDAAS_CASSETTE_MODE"
+
+// CassetteSkipMissingEnv set to true skips the tests without a cassette in replay mode instead of failing them.
+const CassetteSkipMissingEnv = "TERRAFORM_PROVIDER_CIDAAS_CASSETTE_SKIP_MISSING"
+
 var (
 	TestToken string
 	BaseURL   string
+
+	environmentOnce sync.Once
+
+	// cassetteLock serializes the tests while the requests are recorded or replayed, as the transport is shared.
+	cassetteLock  sync.Mutex
+	cassetteTests sync.Map
+
+	randLock    sync.Mutex
+	testSources = map[string]*rand.Rand{}
 )
 
+// configureEnvironment points the acceptance tests to the base url of the cassettes in replay mode and
+// starts the cidaas simulator if no client credentials are set. The simulator runs until the test binary exits.
+func configureEnvironment() {
+	environmentOnce.Do(func() {
+		if recorder.Mode(os.Getenv(CassetteModeEnv)) == recorder.ModeReplay {
+			os.Setenv("BASE_URL", recorder.BaseURL)
+			if os.Getenv("TERRAFORM_PROVIDER_CIDAAS_CLIENT_ID") == "" {
+				os.Setenv("TERRAFORM_PROVIDER_CIDAAS_CLIENT_ID", "replay")
+				os.Setenv("TERRAFORM_PROVIDER_CIDAAS_CLIENT_SECRET", "replay")
+			}
+			return
+		}
+		if os.Getenv("TERRAFORM_PROVIDER_CIDAAS_CLIENT_ID") != "" {
+			return
+		}
+		server := simulator.New()
+		os.Setenv("TERRAFORM_PROVIDER_CIDAAS_CLIENT_ID", simulator.ClientID)
+		os.Setenv("TERRAFORM_PROVIDER_CIDAAS_CLIENT_SECRET", simulator.ClientSecret)
+		os.Setenv("BASE_URL", server.URL)
+	})
+}
+
 func TestAccPreCheck(t *testing.T) {
 	// You can add code here to run prior to any test case execution, for example assertions
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 	t.Helper()
+	configureEnvironment()
+	if mode := os.Getenv(CassetteModeEnv); mode != "" {
+		useCassette(t, recorder.Mode(mode))
+	}
 
 	if os.Getenv("TERRAFORM_PROVIDER_CIDAAS_CLIENT_ID") == "" {
 		t.Fatal("TERRAFORM_PROVIDER_CIDAAS_CLIENT_ID must be set for acceptance tests")
@@ -70,9 +121,51 @@ func TestAccPreCheck(t *testing.T) {
 	BaseURL = os.Getenv("BASE_URL")
 }
 
-// RandString generates a random string with the given length.
+// useCassette records the requests of the test to its cassette or replays them from it until the test is finished.
+// A test without a cassette fails in replay mode, unless CassetteSkipMissingEnv is set to skip it.
+func useCassette(t *testing.T, mode recorder.Mode) {
+	t.Helper()
+	if _, ok := cassetteTests.Load(t.Name()); ok {
+		return
+	}
+	cassette := recorder.Path(".", t.Name())
+	if mode == recorder.ModeReplay {
+		if _, err := os.Stat(cassette); errors.Is(err, fs.ErrNotExist) {
+			if skip, _ := strconv.ParseBool(os.Getenv(CassetteSkipMissingEnv)); skip {
+				t.Skipf("no cassette %s to replay, record it with %s=%s", cassette, CassetteModeEnv, recorder.ModeRecord)
+			}
+			t.Fatalf("no cassette %s to replay, record it with %s=%s or set %s=true to skip the test", cassette, CassetteModeEnv, recorder.ModeRecord, CassetteSkipMissingEnv)
+		}
+	}
+	cassetteLock.Lock()
+	cassetteTests.Store(t.Name(), true)
+	t.Cleanup(func() {
+		util.Transport = http.DefaultTransport
+		cassetteTests.Delete(t.Name())
+		cassetteLock.Unlock()
+	})
+
+	rec, err := recorder.New(mode, cassette, GetBaseURL(), http.DefaultTransport)
+	if err != nil {
+		t.Fatalf("failed to use cassette %s", err.Error())
+	}
+	util.Transport = rec
+	t.Cleanup(func() {
+		if err := rec.Stop(); err != nil {
+			t.Errorf("failed to write cassette %s", err.Error())
+		}
+	})
+}
+
+// RandString generates a random string with the given length. If the requests are recorded or replayed,
+// the strings are generated from the name of the calling test, so that a replay sends the recorded requests.
 func RandString(length int) string {
 	r := rand.New(rand.NewSource(time.Now().UnixNano())) //nolint:gosec
+	if os.Getenv(CassetteModeEnv) != "" {
+		randLock.Lock()
+		defer randLock.Unlock()
+		r = testSource()
+	}
 	charset := "abcdefghijklmnopqrstuvwxyz"
 
 	b := make([]byte, length)
@@ -82,7 +175,38 @@ func RandString(length int) string {
 	return string(b)
 }
 
+// testSource returns the random source of the test in the call stack, seeded with the name of the test.
+// Strings generated during the initialization of the package share one source.
+func testSource() *rand.Rand {
+	name := "init"
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		frame, more := frames.Next()
+		// e.g. github.com/Cidaas/terraform-provider-cidaas/internal/resources_test.TestAccRoleResource_Basic.func1
+		parts := strings.Split(frame.Function[strings.LastIndex(frame.Function, "/")+1:], ".")
+		if len(parts) > 1 && strings.HasPrefix(parts[1], "Test") {
+			name = parts[0] + "." + parts[1]
+			break
+		}
+		if !more {
+			break
+		}
+	}
+
+	if testSources[name] == nil {
+		h := fnv.New64a()
+		h.Write([]byte(name))
+		testSources[name] = rand.New(rand.NewSource(int64(h.Sum64()))) //nolint:gosec
+	}
+	return testSources[name]
+}
+
+// GetBaseURL returns the base url of the cidaas instance, of the simulator if no client credentials are set
+// or of the cassettes in replay mode.
+// It is called while the test case is built, before TestAccPreCheck runs.
 func GetBaseURL() string {
+	configureEnvironment()
 	if BaseURL != "" {
 		return BaseURL
 	}
@@ -0,0 +1,337 @@
+// Package recorder records the requests to cidaas in cassettes and replays them without network access.
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Mode selects whether the requests are sent to cidaas and recorded or served from a cassette.
+type Mode string
+
+const (
+	ModeRecord Mode = "record"
+	ModeReplay Mode = "replay"
+)
+
+// BaseURL replaces the base url of the recorded cidaas instance in the cassettes. It is used as base url in replay mode.
+const BaseURL = "https://cidaas.example.com"
+
+const redacted = "REDACTED"
+
+// secretKeys are the attributes of request and response bodies which are redacted in the cassettes.
+var secretKeys = []string{
+	"access_token", "refresh_token", "id_token", "client_secret", "password", "password_echo",
+	"initial_password", "apikey", "totpkey", "secret", "private_key",
+}
+
+// Cassette is the content of a fixture file.
+type Cassette struct {
+	Interactions []Interaction `yaml:"interactions"`
+}
+
+// Interaction is a request with the response it was answered with.
+type Interaction struct {
+	Request  Request  `yaml:"request"`
+	Response Response `yaml:"response"`
+}
+
+type Request struct {
+	Method string `yaml:"method"`
+	URL    string `yaml:"url"`
+	Body   any    `yaml:"body,omitempty"`
+}
+
+type Response struct {
+	StatusCode int `yaml:"status_code"`
+	Body       any `yaml:"body,omitempty"`
+}
+
+// Recorder is a http.RoundTripper which records the requests to the cassette file in record mode
+// and answers them from the cassette file in replay mode.
+type Recorder struct {
+	mode      Mode
+	path      string
+	baseURL   string
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	replayed []bool
+	// sent are the secrets the test sent itself, they are known from the test configuration and kept in the cassette
+	sent map[string]bool
+	// received are the secrets generated by cidaas, they stay redacted when the test sends them back
+	received map[string]bool
+}
+
+// New creates a recorder for the cassette file. In record mode the requests are sent with the transport to the
+// cidaas instance with the base url. In replay mode the cassette file is read and must exist.
+func New(mode Mode, path, baseURL string, transport http.RoundTripper) (*Recorder, error) {
+	r := &Recorder{
+		mode:      mode,
+		path:      path,
+		baseURL:   strings.TrimRight(baseURL, "/"),
+		transport: transport,
+		sent:      map[string]bool{},
+		received:  map[string]bool{},
+	}
+	switch mode {
+	case ModeRecord:
+	case ModeReplay:
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cassette, record it first: %w", err)
+		}
+		if err = yaml.Unmarshal(content, &r.cassette); err != nil {
+			return nil, fmt.Errorf("failed to parse cassette %s: %w", path, err)
+		}
+		r.replayed = make([]bool, len(r.cassette.Interactions))
+	default:
+		return nil, fmt.Errorf("invalid mode %q, must be one of %s or %s", mode, ModeRecord, ModeReplay)
+	}
+	return r, nil
+}
+
+// Path returns the fixture file of the test, subtests are stored next to their parent test.
+func Path(dir, testName string) string {
+	return filepath.Join(dir, "testdata", "fixtures", strings.ReplaceAll(testName, "/", "_")+".yaml")
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.collectSent(body)
+	request := Request{
+		Method: req.Method,
+		URL:    strings.Replace(req.URL.String(), r.baseURL, BaseURL, 1),
+		Body:   sanitize(body, r.sent),
+	}
+	if r.mode == ModeReplay {
+		return r.replay(req, request)
+	}
+
+	res, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	resBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+	r.collectReceived(resBody)
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request:  request,
+		Response: Response{StatusCode: res.StatusCode, Body: sanitize(resBody, r.sent)},
+	})
+	return res, nil
+}
+
+// replay answers the request with the first interaction not replayed yet which has the same method, url and body.
+// Identical requests are answered in the recorded order, so that a read returns the state of the recorded step.
+func (r *Recorder) replay(req *http.Request, request Request) (*http.Response, error) {
+	for i, interaction := range r.cassette.Interactions {
+		if r.replayed[i] || !matches(interaction.Request, request) {
+			continue
+		}
+		r.replayed[i] = true
+
+		var body []byte
+		if interaction.Response.Body != nil {
+			var err error
+			if body, err = marshal(interaction.Response.Body); err != nil {
+				return nil, fmt.Errorf("failed to replay response body: %w", err)
+			}
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{"Content-Type": []string{"application/json"}},
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("no recorded interaction left for %s %s in %s, record the cassette again", request.Method, request.URL, r.path)
+}
+
+// Stop writes the cassette file in record mode.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	content, err := yaml.Marshal(r.cassette)
+	if err != nil {
+		return fmt.Errorf("failed to marshal cassette: %w", err)
+	}
+	if err = os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create fixtures directory: %w", err)
+	}
+	return os.WriteFile(r.path, content, 0o600)
+}
+
+func matches(recorded, request Request) bool {
+	if recorded.Method != request.Method || recorded.URL != request.URL {
+		return false
+	}
+	recordedBody, err := marshal(recorded.Body)
+	if err != nil {
+		return false
+	}
+	requestBody, err := marshal(request.Body)
+	return err == nil && bytes.Equal(recordedBody, requestBody)
+}
+
+// collectSent remembers the secrets of a request body. The credentials of the token request and the secrets
+// received before are not collected, they belong to the recorded cidaas instance.
+func (r *Recorder) collectSent(body []byte) {
+	var value any
+	if json.Unmarshal(body, &value) != nil || isTokenRequest(value) {
+		return
+	}
+	walkSecrets(value, func(secret string) {
+		if !r.received[secret] {
+			r.sent[secret] = true
+		}
+	})
+}
+
+// collectReceived remembers the secrets of a response body which the test did not send.
+func (r *Recorder) collectReceived(body []byte) {
+	var value any
+	if json.Unmarshal(body, &value) != nil {
+		return
+	}
+	walkSecrets(value, func(secret string) {
+		if !r.sent[secret] {
+			r.received[secret] = true
+		}
+	})
+}
+
+// sanitize parses a JSON body and redacts the secrets in it which the test did not send itself.
+// Other bodies are kept as string.
+func sanitize(body []byte, sent map[string]bool) any {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+	return redact(value, sent)
+}
+
+func redact(value any, sent map[string]bool) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if secret, ok := item.(string); ok && isSecret(key) {
+				if !sent[secret] {
+					v[key] = redacted
+				}
+			} else {
+				v[key] = redact(item, sent)
+			}
+		}
+		// the client of the token request identifies the recorded cidaas instance
+		if isTokenRequest(v) && v["client_id"] != nil {
+			v["client_id"] = redacted
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redact(item, sent)
+		}
+	}
+	return value
+}
+
+// walkSecrets calls fn with the string values of the secret attributes in the value.
+func walkSecrets(value any, fn func(string)) {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if secret, ok := item.(string); ok && isSecret(key) {
+				fn(secret)
+			} else {
+				walkSecrets(item, fn)
+			}
+		}
+	case []any:
+		for _, item := range v {
+			walkSecrets(item, fn)
+		}
+	}
+}
+
+func isTokenRequest(value any) bool {
+	v, ok := value.(map[string]any)
+	if !ok {
+		return false
+	}
+	_, ok = v["grant_type"]
+	return ok
+}
+
+func isSecret(key string) bool {
+	for _, secretKey := range secretKeys {
+		if strings.EqualFold(key, secretKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// marshal returns the JSON of a body read from a cassette, the keys of YAML maps are converted to strings.
+func marshal(body any) ([]byte, error) {
+	if s, ok := body.(string); ok {
+		return []byte(s), nil
+	}
+	return json.Marshal(normalize(body))
+}
+
+func normalize(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = normalize(item)
+		}
+	case map[any]any:
+		m := make(map[string]any, len(v))
+		for key, item := range v {
+			m[fmt.Sprint(key)] = normalize(item)
+		}
+		return m
+	case []any:
+		for i, item := range v {
+			v[i] = normalize(item)
+		}
+	}
+	return value
+}
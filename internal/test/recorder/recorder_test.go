@@ -0,0 +1,137 @@
+package recorder_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Cidaas/terraform-provider-cidaas/internal/test/recorder"
+)
+
+func send(t *testing.T, transport http.RoundTripper, method, url, body string) (int, string, error) {
+	t.Helper()
+	req, err := http.NewRequestWithContext(context.Background(), method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer live-token")
+	res, err := (&http.Client{Transport: transport}).Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer res.Body.Close()
+	resBody, _ := io.ReadAll(res.Body)
+	return res.StatusCode, string(resBody), nil
+}
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token-srv/token":
+			_, _ = w.Write([]byte(`{"access_token":"live-token","expires_in":86400}`))
+		case "/apps-srv/clients":
+			_, _ = w.Write([]byte(`{"success":true,"data":{"client_id":"app","client_secret":"live-secret","client_name":"sample"}}`))
+		case "/roles-srv/role":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	path := recorder.Path(t.TempDir(), t.Name())
+
+	rec, err := recorder.New(recorder.ModeRecord, path, server.URL+"/", http.DefaultTransport)
+	if err != nil {
+		t.Fatalf("Failed to create recorder: %v", err)
+	}
+	send(t, rec, http.MethodPost, server.URL+"/token-srv/token", `{"client_id":"live-client","client_secret":"live-secret","grant_type":"client_credentials"}`)
+	_, recorded, _ := send(t, rec, http.MethodPost, server.URL+"/apps-srv/clients", `{"client_name":"sample"}`)
+	send(t, rec, http.MethodGet, server.URL+"/roles-srv/role?role=admin", "")
+	if err = rec.Stop(); err != nil {
+		t.Fatalf("Failed to write cassette: %v", err)
+	}
+	server.Close()
+
+	if !strings.Contains(recorded, "live-secret") {
+		t.Errorf("Expected the recorded response to be passed on unchanged, got %s", recorded)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read cassette: %v", err)
+	}
+	for _, secret := range []string{"live-token", "live-secret", "live-client", server.URL} {
+		if strings.Contains(string(content), secret) {
+			t.Errorf("Expected %s to be redacted from the cassette:\n%s", secret, content)
+		}
+	}
+
+	rec, err = recorder.New(recorder.ModeReplay, path, recorder.BaseURL, nil)
+	if err != nil {
+		t.Fatalf("Failed to create recorder: %v", err)
+	}
+	status, body, err := send(t, rec, http.MethodPost, recorder.BaseURL+"/token-srv/token", `{"client_id":"other","client_secret":"other","grant_type":"client_credentials"}`)
+	if err != nil || status != http.StatusOK || !strings.Contains(body, `"access_token":"REDACTED"`) {
+		t.Errorf("Expected the token to be replayed, got %d %s %v", status, body, err)
+	}
+	status, body, err = send(t, rec, http.MethodPost, recorder.BaseURL+"/apps-srv/clients", `{"client_name":"sample"}`)
+	if err != nil || status != http.StatusOK || !strings.Contains(body, `"client_id":"app"`) {
+		t.Errorf("Expected the app to be replayed, got %d %s %v", status, body, err)
+	}
+	status, _, err = send(t, rec, http.MethodGet, recorder.BaseURL+"/roles-srv/role?role=admin", "")
+	if err != nil || status != http.StatusNoContent {
+		t.Errorf("Expected status 204 to be replayed, got %d %v", status, err)
+	}
+
+	// every interaction is replayed once
+	if _, _, err = send(t, rec, http.MethodGet, recorder.BaseURL+"/roles-srv/role?role=admin", ""); err == nil {
+		t.Error("Expected an error for a request which is not recorded")
+	}
+}
+
+func TestRecorder_ReplayWithoutCassette(t *testing.T) {
+	if _, err := recorder.New(recorder.ModeReplay, recorder.Path(t.TempDir(), t.Name()), recorder.BaseURL, nil); err == nil {
+		t.Fatal("Expected an error for a missing cassette")
+	}
+	if _, err := recorder.New("live", "", "", nil); err == nil {
+		t.Fatal("Expected an error for an invalid mode")
+	}
+}
+
+func TestRecorder_KeepSentSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/webhook-srv/webhook":
+			_, _ = w.Write([]byte(`{"success":true,"data":{"apikeyDetails":{"apikey":"test-key"},"secret":"generated-secret"}}`))
+		case "/providers-srv/social":
+			_, _ = w.Write([]byte(`{"success":true,"data":{"claims":{"optional_claims":{"id_token":["email"]}}}}`))
+		}
+	}))
+	defer server.Close()
+	path := recorder.Path(t.TempDir(), t.Name())
+
+	rec, err := recorder.New(recorder.ModeRecord, path, server.URL, http.DefaultTransport)
+	if err != nil {
+		t.Fatalf("Failed to create recorder: %v", err)
+	}
+	send(t, rec, http.MethodPost, server.URL+"/webhook-srv/webhook", `{"apikeyDetails":{"apikey":"test-key"}}`)
+	send(t, rec, http.MethodGet, server.URL+"/providers-srv/social", "")
+	// the generated secret is sent back on an update
+	send(t, rec, http.MethodPut, server.URL+"/webhook-srv/webhook", `{"apikeyDetails":{"apikey":"test-key"},"secret":"generated-secret"}`)
+	if err = rec.Stop(); err != nil {
+		t.Fatalf("Failed to write cassette: %v", err)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read cassette: %v", err)
+	}
+	if strings.Count(string(content), "test-key") != 4 {
+		t.Errorf("Expected the secret sent by the test to be kept in the request and response:\n%s", content)
+	}
+	if strings.Contains(string(content), "generated-secret") {
+		t.Errorf("Expected the generated secret to be redacted from the cassette:\n%s", content)
+	}
+	if !strings.Contains(string(content), "- email") {
+		t.Errorf("Expected the id_token claims to be kept:\n%s", content)
+	}
+}
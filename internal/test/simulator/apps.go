@@ -0,0 +1,116 @@
+package simulator
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	collectionApps      = "apps"
+	collectionAppGroups = "appgroups"
+)
+
+func (s *Server) registerApps(mux *http.ServeMux) {
+	mux.HandleFunc("POST /apps-srv/clients", s.createApp)
+	mux.HandleFunc("GET /apps-srv/clients/{clientID}", s.getApp)
+	mux.HandleFunc("PUT /apps-srv/clients", s.updateApp)
+	mux.HandleFunc("DELETE /apps-srv/clients/{clientID}", s.deleteApp)
+	mux.HandleFunc("POST /apps-srv/clients/list", s.listApps)
+
+	mux.HandleFunc("POST /apps-srv/clientgroups", s.createAppGroup)
+	mux.HandleFunc("GET /apps-srv/clientgroups/{clientGroupID}", s.getAppGroup)
+	mux.HandleFunc("PUT /apps-srv/clientgroups", s.updateAppGroup)
+	mux.HandleFunc("DELETE /apps-srv/clientgroups/{clientGroupID}", s.deleteAppGroup)
+}
+
+// createApp generates the client_id unless it is given and always generates the client_secret.
+func (s *Server) createApp(w http.ResponseWriter, r *http.Request) {
+	var app object
+	if !decode(w, r, &app) || !required(w, app, "client_name") {
+		return
+	}
+	if str(app, "client_id") == "" {
+		app["client_id"] = s.newID()
+	}
+	if _, ok := s.get(collectionApps, str(app, "client_id")); ok {
+		writeError(w, http.StatusConflict, fmt.Sprintf("app %s already exists", str(app, "client_id")))
+		return
+	}
+	app["_id"] = s.newID()
+	app["client_secret"] = strings.ReplaceAll(s.newID(), "-", "")
+	writeData(w, s.save(collectionApps, str(app, "client_id"), app))
+}
+
+func (s *Server) getApp(w http.ResponseWriter, r *http.Request) {
+	app, ok := s.get(collectionApps, r.PathValue("clientID"))
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeData(w, app)
+}
+
+func (s *Server) updateApp(w http.ResponseWriter, r *http.Request) {
+	var app object
+	if !decode(w, r, &app) || !required(w, app, "client_id") {
+		return
+	}
+	existing, ok := s.get(collectionApps, str(app, "client_id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("app %s does not exist", str(app, "client_id")))
+		return
+	}
+	// the secret can not be changed with an update
+	delete(app, "client_secret")
+	writeData(w, s.save(collectionApps, str(app, "client_id"), merge(existing, app)))
+}
+
+func (s *Server) deleteApp(w http.ResponseWriter, r *http.Request) {
+	s.delete(collectionApps, r.PathValue("clientID"))
+	writeDeleted(w)
+}
+
+func (s *Server) listApps(w http.ResponseWriter, _ *http.Request) {
+	writeData(w, s.list(collectionApps, nil))
+}
+
+func (s *Server) createAppGroup(w http.ResponseWriter, r *http.Request) {
+	var group object
+	if !decode(w, r, &group) || !required(w, group, "client_group_id") {
+		return
+	}
+	if _, ok := s.get(collectionAppGroups, str(group, "client_group_id")); ok {
+		writeError(w, http.StatusConflict, fmt.Sprintf("app group %s already exists", str(group, "client_group_id")))
+		return
+	}
+	group["_id"] = s.newID()
+	writeData(w, s.save(collectionAppGroups, str(group, "client_group_id"), group))
+}
+
+func (s *Server) getAppGroup(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.get(collectionAppGroups, r.PathValue("clientGroupID"))
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeData(w, group)
+}
+
+func (s *Server) updateAppGroup(w http.ResponseWriter, r *http.Request) {
+	var group object
+	if !decode(w, r, &group) || !required(w, group, "client_group_id") {
+		return
+	}
+	existing, ok := s.get(collectionAppGroups, str(group, "client_group_id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("app group %s does not exist", str(group, "client_group_id")))
+		return
+	}
+	writeData(w, s.save(collectionAppGroups, str(group, "client_group_id"), merge(existing, group)))
+}
+
+func (s *Server) deleteAppGroup(w http.ResponseWriter, r *http.Request) {
+	s.delete(collectionAppGroups, r.PathValue("clientGroupID"))
+	writeDeleted(w)
+}
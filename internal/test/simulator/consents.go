@@ -0,0 +1,166 @@
+package simulator
+
+import "net/http"
+
+const (
+	collectionConsentGroups   = "consentgroups"
+	collectionConsents        = "consents"
+	collectionConsentVersions = "consentversions"
+	collectionConsentLocales  = "consentlocales"
+)
+
+func (s *Server) registerConsents(mux *http.ServeMux) {
+	mux.HandleFunc("POST /consent-management-srv/v2/groups", s.upsertConsentGroup)
+	mux.HandleFunc("GET /consent-management-srv/v2/groups/{id}", s.getConsentGroup)
+	mux.HandleFunc("DELETE /consent-management-srv/v2/groups/{id}", s.deleteConsentGroup)
+
+	mux.HandleFunc("POST /consent-management-srv/v2/consent/instance", s.upsertConsent)
+	mux.HandleFunc("GET /consent-management-srv/v2/consent/instance/all/list", s.listConsents)
+	mux.HandleFunc("GET /consent-management-srv/v2/consent/instance/{groupID}", s.getConsentInstances)
+	mux.HandleFunc("DELETE /consent-management-srv/v2/consent/instance/{id}", s.deleteConsent)
+
+	mux.HandleFunc("POST /consent-management-srv/v2/consent/versions", s.upsertConsentVersion)
+	mux.HandleFunc("GET /consent-management-srv/v2/consent/versions/list/{consentID}", s.listConsentVersions)
+	mux.HandleFunc("DELETE /consent-management-srv/v2/consent/versions/{id}", s.deleteConsentVersion)
+
+	mux.HandleFunc("POST /consent-management-srv/v2/consent/locale", s.upsertConsentLocale)
+	mux.HandleFunc("GET /consent-management-srv/v2/consent/locale/{versionID}", s.getConsentLocale)
+	mux.HandleFunc("DELETE /consent-management-srv/v2/consent/locale/{versionID}", s.deleteConsentLocale)
+}
+
+// upsertConsentGroup updates the consent group with the _id or the group_name of the payload, like cidaas the name is unique.
+func (s *Server) upsertConsentGroup(w http.ResponseWriter, r *http.Request) {
+	var group object
+	if !decode(w, r, &group) || !required(w, group, "group_name") {
+		return
+	}
+	if str(group, "_id") == "" {
+		for _, existing := range s.list(collectionConsentGroups, func(obj object) bool { return str(obj, "group_name") == str(group, "group_name") }) {
+			group["_id"] = existing["_id"]
+		}
+	}
+	writeData(w, s.upsert(collectionConsentGroups, group))
+}
+
+func (s *Server) getConsentGroup(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.get(collectionConsentGroups, r.PathValue("id"))
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeData(w, group)
+}
+
+func (s *Server) deleteConsentGroup(w http.ResponseWriter, r *http.Request) {
+	s.delete(collectionConsentGroups, r.PathValue("id"))
+	writeDeleted(w)
+}
+
+func (s *Server) upsertConsent(w http.ResponseWriter, r *http.Request) {
+	var consent object
+	if !decode(w, r, &consent) || !required(w, consent, "consent_group_id", "consent_name") {
+		return
+	}
+	writeData(w, s.upsert(collectionConsents, consent))
+}
+
+// getConsentInstances returns the consents of a consent group and responds with status 204 if there are none.
+func (s *Server) getConsentInstances(w http.ResponseWriter, r *http.Request) {
+	groupID := r.PathValue("groupID")
+	consents := s.list(collectionConsents, func(obj object) bool { return str(obj, "consent_group_id") == groupID })
+	if len(consents) == 0 {
+		writeNotFound(w)
+		return
+	}
+	writeData(w, consents)
+}
+
+func (s *Server) listConsents(w http.ResponseWriter, _ *http.Request) {
+	writeData(w, s.list(collectionConsents, nil))
+}
+
+func (s *Server) deleteConsent(w http.ResponseWriter, r *http.Request) {
+	s.delete(collectionConsents, r.PathValue("id"))
+	writeDeleted(w)
+}
+
+func consentLocaleKey(versionID, locale string) string {
+	return versionID + "|" + locale
+}
+
+// upsertConsentVersion stores the consent_locale of the version as its first locale.
+func (s *Server) upsertConsentVersion(w http.ResponseWriter, r *http.Request) {
+	var version object
+	if !decode(w, r, &version) || !required(w, version, "consent_id") {
+		return
+	}
+	if _, ok := s.get(collectionConsents, str(version, "consent_id")); !ok {
+		writeError(w, http.StatusBadRequest, "consent "+str(version, "consent_id")+" does not exist")
+		return
+	}
+	version = s.upsert(collectionConsentVersions, version)
+	if locale, ok := version["consent_locale"].(object); ok && str(locale, "locale") != "" {
+		s.save(collectionConsentLocales, consentLocaleKey(str(version, "_id"), str(locale, "locale")), object{
+			"consent_version_id": str(version, "_id"),
+			"consent_id":         str(version, "consent_id"),
+			"locale":             str(locale, "locale"),
+			"content":            locale["content"],
+			"url":                locale["url"],
+		})
+	}
+	writeData(w, version)
+}
+
+func (s *Server) listConsentVersions(w http.ResponseWriter, r *http.Request) {
+	consentID := r.PathValue("consentID")
+	writeData(w, s.list(collectionConsentVersions, func(obj object) bool { return str(obj, "consent_id") == consentID }))
+}
+
+// deleteConsentVersion removes the version together with its locales.
+func (s *Server) deleteConsentVersion(w http.ResponseWriter, r *http.Request) {
+	versionID := r.PathValue("id")
+	s.delete(collectionConsentVersions, versionID)
+	for _, locale := range s.list(collectionConsentLocales, func(obj object) bool { return str(obj, "consent_version_id") == versionID }) {
+		s.delete(collectionConsentLocales, consentLocaleKey(versionID, str(locale, "locale")))
+	}
+	writeDeleted(w)
+}
+
+func (s *Server) upsertConsentLocale(w http.ResponseWriter, r *http.Request) {
+	var locale object
+	if !decode(w, r, &locale) || !required(w, locale, "consent_version_id", "locale") {
+		return
+	}
+	if _, ok := s.get(collectionConsentVersions, str(locale, "consent_version_id")); !ok {
+		writeError(w, http.StatusBadRequest, "consent version "+str(locale, "consent_version_id")+" does not exist")
+		return
+	}
+	key := consentLocaleKey(str(locale, "consent_version_id"), str(locale, "locale"))
+	existing, _ := s.get(collectionConsentLocales, key)
+	writeData(w, s.withVersion(s.save(collectionConsentLocales, key, merge(existing, locale))))
+}
+
+// getConsentLocale responds with status 204 if the version has no content for the locale.
+func (s *Server) getConsentLocale(w http.ResponseWriter, r *http.Request) {
+	locale, ok := s.get(collectionConsentLocales, consentLocaleKey(r.PathValue("versionID"), r.URL.Query().Get("locale")))
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeData(w, s.withVersion(locale))
+}
+
+// withVersion adds the scopes and required fields of the consent version to the locale.
+func (s *Server) withVersion(locale object) object {
+	version, _ := s.get(collectionConsentVersions, str(locale, "consent_version_id"))
+	return merge(locale, object{
+		"consent_id":      str(version, "consent_id"),
+		"scopes":          version["scopes"],
+		"required_fields": version["required_fields"],
+	})
+}
+
+func (s *Server) deleteConsentLocale(w http.ResponseWriter, r *http.Request) {
+	s.delete(collectionConsentLocales, consentLocaleKey(r.PathValue("versionID"), r.URL.Query().Get("locale")))
+	writeDeleted(w)
+}
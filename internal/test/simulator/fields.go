@@ -0,0 +1,97 @@
+package simulator
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	collectionRegistrationFields = "registrationfields"
+
+	fieldTypeSystem = "SYSTEM"
+)
+
+func (s *Server) registerRegistrationFields(mux *http.ServeMux) {
+	mux.HandleFunc("POST /fieldsetup-srv/fields", s.upsertRegistrationField)
+	mux.HandleFunc("GET /fieldsetup-srv/fields/{fieldKey}", s.getRegistrationField)
+	mux.HandleFunc("DELETE /fieldsetup-srv/fields/{fieldKey}", s.deleteRegistrationField)
+	mux.HandleFunc("POST /fieldsetup-srv/fields/order", s.orderRegistrationFields)
+	mux.HandleFunc("GET /registration-setup-srv/fields/list", s.listRegistrationFields)
+}
+
+// baseDataTypes are the base data types cidaas derives from the data types of the registration fields, string is the default.
+var baseDataTypes = map[string]string{
+	"NUMBER":      "double",
+	"DATE":        "datetime",
+	"DAYDATE":     "datetime",
+	"CHECKBOX":    "bool",
+	"CONSENT":     "bool",
+	"MULTISELECT": "array",
+	"ARRAY":       "array",
+}
+
+func baseDataType(dataType string) string {
+	if baseDataType, ok := baseDataTypes[dataType]; ok {
+		return baseDataType
+	}
+	return "string"
+}
+
+// upsertRegistrationField creates a custom field or updates a field. The data type of a system field can not be changed.
+func (s *Server) upsertRegistrationField(w http.ResponseWriter, r *http.Request) {
+	var field object
+	if !decode(w, r, &field) || !required(w, field, "fieldKey", "dataType") {
+		return
+	}
+	existing, ok := s.get(collectionRegistrationFields, str(field, "fieldKey"))
+	if !ok {
+		existing = object{"_id": s.newID(), "order": len(s.list(collectionRegistrationFields, nil)) + 1}
+	} else if str(existing, "fieldType") == fieldTypeSystem && !strings.EqualFold(str(existing, "dataType"), str(field, "dataType")) {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("the data type of the system field %s can not be changed", str(field, "fieldKey")))
+		return
+	}
+	field["baseDataType"] = baseDataType(str(field, "dataType"))
+	writeData(w, s.save(collectionRegistrationFields, str(field, "fieldKey"), merge(existing, field)))
+}
+
+func (s *Server) getRegistrationField(w http.ResponseWriter, r *http.Request) {
+	field, ok := s.get(collectionRegistrationFields, r.PathValue("fieldKey"))
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeData(w, field)
+}
+
+func (s *Server) deleteRegistrationField(w http.ResponseWriter, r *http.Request) {
+	if field, ok := s.get(collectionRegistrationFields, r.PathValue("fieldKey")); ok && str(field, "fieldType") == fieldTypeSystem {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("system field %s can not be deleted", r.PathValue("fieldKey")))
+		return
+	}
+	s.delete(collectionRegistrationFields, r.PathValue("fieldKey"))
+	writeDeleted(w)
+}
+
+// orderRegistrationFields sets the order of the given fields. Unknown fields are rejected before any field is changed.
+func (s *Server) orderRegistrationFields(w http.ResponseWriter, r *http.Request) {
+	var order []object
+	if !decode(w, r, &order) {
+		return
+	}
+	for _, item := range order {
+		if _, ok := s.get(collectionRegistrationFields, str(item, "fieldKey")); !ok {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("registration field %s does not exist", str(item, "fieldKey")))
+			return
+		}
+	}
+	for _, item := range order {
+		field, _ := s.get(collectionRegistrationFields, str(item, "fieldKey"))
+		s.save(collectionRegistrationFields, str(item, "fieldKey"), merge(field, object{"order": item["order"]}))
+	}
+	writeData(w, true)
+}
+
+func (s *Server) listRegistrationFields(w http.ResponseWriter, _ *http.Request) {
+	writeData(w, s.list(collectionRegistrationFields, nil))
+}
@@ -0,0 +1,249 @@
+package simulator
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	collectionGroupTypes        = "grouptypes"
+	collectionGroupCustomFields = "groupcustomfields"
+	collectionUserGroups        = "usergroups"
+	collectionGroupMembers      = "groupmembers"
+
+	rootUserGroupID = "root"
+)
+
+func (s *Server) registerGroups(mux *http.ServeMux) {
+	mux.HandleFunc("POST /groups-srv/grouptypes", s.createGroupType)
+	mux.HandleFunc("GET /groups-srv/grouptypes", s.getGroupType)
+	mux.HandleFunc("PUT /groups-srv/grouptypes", s.updateGroupType)
+	mux.HandleFunc("DELETE /groups-srv/grouptypes/{groupType}", s.deleteGroupType)
+	mux.HandleFunc("POST /groups-srv/graph/grouptypes", s.listGroupTypes)
+
+	mux.HandleFunc("POST /groups-srv/fieldsetup", s.createGroupCustomField)
+	mux.HandleFunc("GET /groups-srv/fieldsetup/list", s.listGroupCustomFields)
+	mux.HandleFunc("GET /groups-srv/fieldsetup/{fieldKey}", s.getGroupCustomField)
+	mux.HandleFunc("PUT /groups-srv/fieldsetup", s.updateGroupCustomField)
+	mux.HandleFunc("DELETE /groups-srv/fieldsetup/{fieldKey}", s.deleteGroupCustomField)
+
+	mux.HandleFunc("POST /groups-srv/usergroups", s.createUserGroup)
+	mux.HandleFunc("GET /groups-srv/usergroups/{groupID}", s.getUserGroup)
+	mux.HandleFunc("PUT /groups-srv/usergroups", s.updateUserGroup)
+	mux.HandleFunc("DELETE /groups-srv/usergroups/{groupID}", s.deleteUserGroup)
+	mux.HandleFunc("POST /groups-srv/graph/usergroups", s.listSubGroups)
+
+	mux.HandleFunc("POST /groups-srv/usergroups/{groupID}/members", s.addGroupMember)
+	mux.HandleFunc("GET /groups-srv/usergroups/{groupID}/members/{sub}", s.getGroupMember)
+	mux.HandleFunc("PUT /groups-srv/usergroups/{groupID}/members/{sub}", s.updateGroupMember)
+	mux.HandleFunc("DELETE /groups-srv/usergroups/{groupID}/members/{sub}", s.removeGroupMember)
+}
+
+func (s *Server) createGroupType(w http.ResponseWriter, r *http.Request) {
+	var groupType object
+	if !decode(w, r, &groupType) || !required(w, groupType, "groupType") {
+		return
+	}
+	if _, ok := s.get(collectionGroupTypes, str(groupType, "groupType")); ok {
+		writeError(w, http.StatusConflict, fmt.Sprintf("group type %s already exists", str(groupType, "groupType")))
+		return
+	}
+	groupType["_id"] = s.newID()
+	writeData(w, s.save(collectionGroupTypes, str(groupType, "groupType"), groupType))
+}
+
+func (s *Server) getGroupType(w http.ResponseWriter, r *http.Request) {
+	groupType, ok := s.get(collectionGroupTypes, r.URL.Query().Get("groupType"))
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeData(w, groupType)
+}
+
+func (s *Server) updateGroupType(w http.ResponseWriter, r *http.Request) {
+	var groupType object
+	if !decode(w, r, &groupType) || !required(w, groupType, "groupType") {
+		return
+	}
+	existing, ok := s.get(collectionGroupTypes, str(groupType, "groupType"))
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("group type %s does not exist", str(groupType, "groupType")))
+		return
+	}
+	writeData(w, s.save(collectionGroupTypes, str(groupType, "groupType"), merge(existing, groupType)))
+}
+
+func (s *Server) deleteGroupType(w http.ResponseWriter, r *http.Request) {
+	s.delete(collectionGroupTypes, r.PathValue("groupType"))
+	writeDeleted(w)
+}
+
+func (s *Server) listGroupTypes(w http.ResponseWriter, _ *http.Request) {
+	writeData(w, s.list(collectionGroupTypes, nil))
+}
+
+func (s *Server) createGroupCustomField(w http.ResponseWriter, r *http.Request) {
+	var field object
+	if !decode(w, r, &field) || !required(w, field, "fieldKey", "dataType") {
+		return
+	}
+	if _, ok := s.get(collectionGroupCustomFields, str(field, "fieldKey")); ok {
+		writeError(w, http.StatusConflict, fmt.Sprintf("custom field %s already exists", str(field, "fieldKey")))
+		return
+	}
+	field["_id"] = s.newID()
+	writeData(w, s.save(collectionGroupCustomFields, str(field, "fieldKey"), field))
+}
+
+func (s *Server) getGroupCustomField(w http.ResponseWriter, r *http.Request) {
+	field, ok := s.get(collectionGroupCustomFields, r.PathValue("fieldKey"))
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeData(w, field)
+}
+
+func (s *Server) updateGroupCustomField(w http.ResponseWriter, r *http.Request) {
+	var field object
+	if !decode(w, r, &field) || !required(w, field, "fieldKey") {
+		return
+	}
+	existing, ok := s.get(collectionGroupCustomFields, str(field, "fieldKey"))
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("custom field %s does not exist", str(field, "fieldKey")))
+		return
+	}
+	writeData(w, s.save(collectionGroupCustomFields, str(field, "fieldKey"), merge(existing, field)))
+}
+
+func (s *Server) deleteGroupCustomField(w http.ResponseWriter, r *http.Request) {
+	s.delete(collectionGroupCustomFields, r.PathValue("fieldKey"))
+	writeDeleted(w)
+}
+
+func (s *Server) listGroupCustomFields(w http.ResponseWriter, _ *http.Request) {
+	writeData(w, s.list(collectionGroupCustomFields, nil))
+}
+
+func (s *Server) createUserGroup(w http.ResponseWriter, r *http.Request) {
+	var group object
+	if !decode(w, r, &group) || !required(w, group, "groupId", "groupName", "groupType") {
+		return
+	}
+	if _, ok := s.get(collectionUserGroups, str(group, "groupId")); ok {
+		writeError(w, http.StatusConflict, fmt.Sprintf("user group %s already exists", str(group, "groupId")))
+		return
+	}
+	if _, ok := s.get(collectionGroupTypes, str(group, "groupType")); !ok {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("group type %s does not exist", str(group, "groupType")))
+		return
+	}
+	if str(group, "parentId") == "" {
+		group["parentId"] = rootUserGroupID
+	}
+	if _, ok := s.get(collectionUserGroups, str(group, "parentId")); !ok && str(group, "parentId") != rootUserGroupID {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("parent group %s does not exist", str(group, "parentId")))
+		return
+	}
+	group["_id"] = s.newID()
+	writeData(w, s.save(collectionUserGroups, str(group, "groupId"), group))
+}
+
+func (s *Server) getUserGroup(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.get(collectionUserGroups, r.PathValue("groupID"))
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeData(w, group)
+}
+
+func (s *Server) updateUserGroup(w http.ResponseWriter, r *http.Request) {
+	var group object
+	if !decode(w, r, &group) || !required(w, group, "groupId") {
+		return
+	}
+	existing, ok := s.get(collectionUserGroups, str(group, "groupId"))
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("user group %s does not exist", str(group, "groupId")))
+		return
+	}
+	writeData(w, s.save(collectionUserGroups, str(group, "groupId"), merge(existing, group)))
+}
+
+// deleteUserGroup removes the group together with its members.
+func (s *Server) deleteUserGroup(w http.ResponseWriter, r *http.Request) {
+	groupID := r.PathValue("groupID")
+	s.delete(collectionUserGroups, groupID)
+	for _, member := range s.list(collectionGroupMembers, func(obj object) bool { return str(obj, "groupId") == groupID }) {
+		s.delete(collectionGroupMembers, groupMemberKey(groupID, str(member, "sub")))
+	}
+	writeDeleted(w)
+}
+
+// listSubGroups responds with status 204 if the parent has no sub groups, like cidaas does.
+func (s *Server) listSubGroups(w http.ResponseWriter, r *http.Request) {
+	var payload object
+	if !decode(w, r, &payload) {
+		return
+	}
+	parentID := str(payload, "parentId")
+	groups := s.list(collectionUserGroups, func(obj object) bool { return str(obj, "parentId") == parentID })
+	if len(groups) == 0 {
+		writeNotFound(w)
+		return
+	}
+	writeData(w, object{"groups": groups})
+}
+
+func groupMemberKey(groupID, sub string) string {
+	return groupID + "|" + sub
+}
+
+func (s *Server) addGroupMember(w http.ResponseWriter, r *http.Request) {
+	var member object
+	if !decode(w, r, &member) || !required(w, member, "sub") {
+		return
+	}
+	groupID := r.PathValue("groupID")
+	if _, ok := s.get(collectionUserGroups, groupID); !ok {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("user group %s does not exist", groupID))
+		return
+	}
+	if _, ok := s.get(collectionUsers, str(member, "sub")); !ok {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("user %s does not exist", str(member, "sub")))
+		return
+	}
+	member["groupId"] = groupID
+	writeData(w, s.save(collectionGroupMembers, groupMemberKey(groupID, str(member, "sub")), member))
+}
+
+func (s *Server) getGroupMember(w http.ResponseWriter, r *http.Request) {
+	member, ok := s.get(collectionGroupMembers, groupMemberKey(r.PathValue("groupID"), r.PathValue("sub")))
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeData(w, member)
+}
+
+func (s *Server) updateGroupMember(w http.ResponseWriter, r *http.Request) {
+	var member object
+	if !decode(w, r, &member) {
+		return
+	}
+	key := groupMemberKey(r.PathValue("groupID"), r.PathValue("sub"))
+	existing, ok := s.get(collectionGroupMembers, key)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("user %s is not a member of the group %s", r.PathValue("sub"), r.PathValue("groupID")))
+		return
+	}
+	writeData(w, s.save(collectionGroupMembers, key, merge(existing, member)))
+}
+
+func (s *Server) removeGroupMember(w http.ResponseWriter, r *http.Request) {
+	s.delete(collectionGroupMembers, groupMemberKey(r.PathValue("groupID"), r.PathValue("sub")))
+	writeDeleted(w)
+}
@@ -0,0 +1,35 @@
+package simulator
+
+import "net/http"
+
+const collectionHostedPages = "hostedpages"
+
+func (s *Server) registerHostedPages(mux *http.ServeMux) {
+	mux.HandleFunc("POST /hostedpages-srv/hpgroup", s.upsertHostedPageGroup)
+	mux.HandleFunc("GET /hostedpages-srv/hpgroup/{name}", s.getHostedPageGroup)
+	mux.HandleFunc("DELETE /hostedpages-srv/hpgroup/{name}", s.deleteHostedPageGroup)
+}
+
+// upsertHostedPageGroup stores the hosted page group by its name, which is sent as _id.
+func (s *Server) upsertHostedPageGroup(w http.ResponseWriter, r *http.Request) {
+	var group object
+	if !decode(w, r, &group) || !required(w, group, "_id") {
+		return
+	}
+	existing, _ := s.get(collectionHostedPages, str(group, "_id"))
+	writeData(w, s.save(collectionHostedPages, str(group, "_id"), merge(existing, group)))
+}
+
+func (s *Server) getHostedPageGroup(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.get(collectionHostedPages, r.PathValue("name"))
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeData(w, group)
+}
+
+func (s *Server) deleteHostedPageGroup(w http.ResponseWriter, r *http.Request) {
+	s.delete(collectionHostedPages, r.PathValue("name"))
+	writeDeleted(w)
+}
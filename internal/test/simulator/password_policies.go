@@ -0,0 +1,53 @@
+package simulator
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const collectionPasswordPolicies = "passwordpolicies"
+
+func (s *Server) registerPasswordPolicies(mux *http.ServeMux) {
+	mux.HandleFunc("POST /verification-actions-srv/policies", s.createPasswordPolicy)
+	mux.HandleFunc("GET /verification-actions-srv/policies/{id}", s.getPasswordPolicy)
+	mux.HandleFunc("PUT /verification-actions-srv/policies", s.updatePasswordPolicy)
+	mux.HandleFunc("DELETE /verification-actions-srv/policies/{id}", s.deletePasswordPolicy)
+}
+
+func (s *Server) createPasswordPolicy(w http.ResponseWriter, r *http.Request) {
+	var policy object
+	if !decode(w, r, &policy) || !required(w, policy, "policy_name") {
+		return
+	}
+	policy["_id"] = s.newID()
+	writeData(w, s.save(collectionPasswordPolicies, str(policy, "_id"), policy))
+}
+
+func (s *Server) getPasswordPolicy(w http.ResponseWriter, r *http.Request) {
+	policy, ok := s.get(collectionPasswordPolicies, r.PathValue("id"))
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeData(w, policy)
+}
+
+// updatePasswordPolicy responds with true instead of the policy, like cidaas does.
+func (s *Server) updatePasswordPolicy(w http.ResponseWriter, r *http.Request) {
+	var policy object
+	if !decode(w, r, &policy) || !required(w, policy, "_id") {
+		return
+	}
+	existing, ok := s.get(collectionPasswordPolicies, str(policy, "_id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("password policy %s does not exist", str(policy, "_id")))
+		return
+	}
+	s.save(collectionPasswordPolicies, str(policy, "_id"), merge(existing, policy))
+	writeData(w, true)
+}
+
+func (s *Server) deletePasswordPolicy(w http.ResponseWriter, r *http.Request) {
+	s.delete(collectionPasswordPolicies, r.PathValue("id"))
+	writeDeleted(w)
+}
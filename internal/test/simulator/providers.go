@@ -0,0 +1,110 @@
+package simulator
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	collectionCustomProviders = "customproviders"
+	collectionSocialProviders = "socialproviders"
+)
+
+func (s *Server) registerProviders(mux *http.ServeMux) {
+	mux.HandleFunc("POST /providers-srv/custom", s.createCustomProvider)
+	mux.HandleFunc("PUT /providers-srv/custom", s.updateCustomProvider)
+	mux.HandleFunc("GET /providers-srv/custom", s.listCustomProviders)
+	mux.HandleFunc("GET /providers-srv/custom/{providerName}", s.getCustomProvider)
+	mux.HandleFunc("DELETE /providers-srv/custom/{providerName}", s.deleteCustomProvider)
+
+	mux.HandleFunc("POST /providers-srv/multi/providers", s.upsertSocialProvider)
+	mux.HandleFunc("GET /providers-srv/multi/providers", s.getSocialProvider)
+	mux.HandleFunc("DELETE /providers-srv/multi/providers/{providerName}/{id}", s.deleteSocialProvider)
+	mux.HandleFunc("GET /providers-srv/providers/enabled/list", s.listSocialProviders)
+}
+
+// createCustomProvider stores the provider by its name in lowercase, like cidaas the lookup ignores the case.
+func (s *Server) createCustomProvider(w http.ResponseWriter, r *http.Request) {
+	var provider object
+	if !decode(w, r, &provider) || !required(w, provider, "provider_name", "standard_type") {
+		return
+	}
+	key := strings.ToLower(str(provider, "provider_name"))
+	if _, ok := s.get(collectionCustomProviders, key); ok {
+		writeError(w, http.StatusConflict, fmt.Sprintf("provider %s already exists", key))
+		return
+	}
+	provider["_id"] = s.newID()
+	provider["provider_name"] = key
+	writeData(w, s.save(collectionCustomProviders, key, provider))
+}
+
+func (s *Server) updateCustomProvider(w http.ResponseWriter, r *http.Request) {
+	var provider object
+	if !decode(w, r, &provider) || !required(w, provider, "provider_name") {
+		return
+	}
+	key := strings.ToLower(str(provider, "provider_name"))
+	existing, ok := s.get(collectionCustomProviders, key)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("provider %s does not exist", key))
+		return
+	}
+	provider["provider_name"] = key
+	writeData(w, s.save(collectionCustomProviders, key, merge(existing, provider)))
+}
+
+func (s *Server) getCustomProvider(w http.ResponseWriter, r *http.Request) {
+	provider, ok := s.get(collectionCustomProviders, strings.ToLower(r.PathValue("providerName")))
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeData(w, provider)
+}
+
+func (s *Server) listCustomProviders(w http.ResponseWriter, _ *http.Request) {
+	writeData(w, s.list(collectionCustomProviders, nil))
+}
+
+func (s *Server) deleteCustomProvider(w http.ResponseWriter, r *http.Request) {
+	s.delete(collectionCustomProviders, strings.ToLower(r.PathValue("providerName")))
+	writeDeleted(w)
+}
+
+func socialProviderKey(providerName, id string) string {
+	return providerName + "|" + id
+}
+
+// upsertSocialProvider creates a social provider or updates the provider with the id of the payload.
+func (s *Server) upsertSocialProvider(w http.ResponseWriter, r *http.Request) {
+	var provider object
+	if !decode(w, r, &provider) || !required(w, provider, "provider_name", "name") {
+		return
+	}
+	existing, ok := s.get(collectionSocialProviders, socialProviderKey(str(provider, "provider_name"), str(provider, "id")))
+	if !ok {
+		provider["id"] = s.newID()
+	}
+	key := socialProviderKey(str(provider, "provider_name"), str(provider, "id"))
+	writeData(w, s.save(collectionSocialProviders, key, merge(existing, provider)))
+}
+
+func (s *Server) getSocialProvider(w http.ResponseWriter, r *http.Request) {
+	provider, ok := s.get(collectionSocialProviders, socialProviderKey(r.URL.Query().Get("provider_name"), r.URL.Query().Get("provider_id")))
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeData(w, provider)
+}
+
+func (s *Server) deleteSocialProvider(w http.ResponseWriter, r *http.Request) {
+	s.delete(collectionSocialProviders, socialProviderKey(r.PathValue("providerName"), r.PathValue("id")))
+	writeDeleted(w)
+}
+
+func (s *Server) listSocialProviders(w http.ResponseWriter, _ *http.Request) {
+	writeData(w, s.list(collectionSocialProviders, func(obj object) bool { return obj["enabled"] == true }))
+}
@@ -0,0 +1,85 @@
+package simulator
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	collectionRoles     = "roles"
+	collectionUserRoles = "userroles"
+)
+
+func (s *Server) registerRoles(mux *http.ServeMux) {
+	mux.HandleFunc("POST /roles-srv/role", s.upsertRole)
+	mux.HandleFunc("GET /roles-srv/role", s.getRole)
+	mux.HandleFunc("DELETE /roles-srv/role", s.deleteRole)
+	mux.HandleFunc("POST /groups-srv/graph/roles", s.listRoles)
+	mux.HandleFunc("POST /roles-srv/userroles", s.assignUserRole)
+	mux.HandleFunc("GET /roles-srv/userroles", s.getRoleMembers)
+	mux.HandleFunc("DELETE /roles-srv/userroles", s.unassignUserRole)
+}
+
+func (s *Server) upsertRole(w http.ResponseWriter, r *http.Request) {
+	var role object
+	if !decode(w, r, &role) || !required(w, role, "role") {
+		return
+	}
+	existing, _ := s.get(collectionRoles, str(role, "role"))
+	writeData(w, s.save(collectionRoles, str(role, "role"), merge(existing, role)))
+}
+
+func (s *Server) getRole(w http.ResponseWriter, r *http.Request) {
+	role, ok := s.get(collectionRoles, r.URL.Query().Get("role"))
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeData(w, role)
+}
+
+func (s *Server) deleteRole(w http.ResponseWriter, r *http.Request) {
+	role := r.URL.Query().Get("role")
+	s.delete(collectionRoles, role)
+	for _, userRole := range s.list(collectionUserRoles, func(obj object) bool { return str(obj, "role") == role }) {
+		s.delete(collectionUserRoles, userRoleKey(role, str(userRole, "sub")))
+	}
+	writeDeleted(w)
+}
+
+func (s *Server) listRoles(w http.ResponseWriter, _ *http.Request) {
+	writeData(w, s.list(collectionRoles, nil))
+}
+
+func userRoleKey(role, sub string) string {
+	return role + "|" + sub
+}
+
+func (s *Server) assignUserRole(w http.ResponseWriter, r *http.Request) {
+	var userRole object
+	if !decode(w, r, &userRole) || !required(w, userRole, "role", "sub") {
+		return
+	}
+	if _, ok := s.get(collectionRoles, str(userRole, "role")); !ok {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("role %s does not exist", str(userRole, "role")))
+		return
+	}
+	if _, ok := s.get(collectionUsers, str(userRole, "sub")); !ok {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("user %s does not exist", str(userRole, "sub")))
+		return
+	}
+	writeData(w, s.save(collectionUserRoles, userRoleKey(str(userRole, "role"), str(userRole, "sub")), object{
+		"role": str(userRole, "role"),
+		"sub":  str(userRole, "sub"),
+	}))
+}
+
+func (s *Server) getRoleMembers(w http.ResponseWriter, r *http.Request) {
+	role := r.URL.Query().Get("role")
+	writeData(w, s.list(collectionUserRoles, func(obj object) bool { return str(obj, "role") == role }))
+}
+
+func (s *Server) unassignUserRole(w http.ResponseWriter, r *http.Request) {
+	s.delete(collectionUserRoles, userRoleKey(r.URL.Query().Get("role"), r.URL.Query().Get("sub")))
+	writeDeleted(w)
+}
@@ -0,0 +1,125 @@
+package simulator
+
+import (
+	"net/http"
+	"strings"
+)
+
+const (
+	collectionScopes      = "scopes"
+	collectionScopeGroups = "scopegroups"
+)
+
+func (s *Server) registerScopes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /scopes-srv/scope", s.upsertScope)
+	mux.HandleFunc("GET /scopes-srv/scope", s.getScope)
+	mux.HandleFunc("GET /scopes-srv/scope/list", s.listScopes)
+	mux.HandleFunc("DELETE /scopes-srv/scope/{scopeKey}", s.deleteScope)
+	mux.HandleFunc("DELETE /scopes-srv/scope/{scopeKey}/locale/{locale}", s.deleteScopeLocale)
+	mux.HandleFunc("POST /scopes-srv/group", s.upsertScopeGroup)
+	mux.HandleFunc("GET /scopes-srv/group", s.getScopeGroup)
+	mux.HandleFunc("GET /scopes-srv/group/list", s.listScopeGroups)
+	mux.HandleFunc("DELETE /scopes-srv/group/{groupName}", s.deleteScopeGroup)
+}
+
+// upsertScope merges the localized descriptions by locale, like cidaas locales are only removed with the locale endpoint.
+// A new scope is owned by ADMIN.
+func (s *Server) upsertScope(w http.ResponseWriter, r *http.Request) {
+	var scope object
+	if !decode(w, r, &scope) || !required(w, scope, "scopeKey") {
+		return
+	}
+	key := strings.ToLower(str(scope, "scopeKey"))
+	scope["scopeKey"] = key
+
+	existing, ok := s.get(collectionScopes, key)
+	if !ok {
+		existing = object{"_id": s.newID(), "scopeOwner": "ADMIN"}
+	}
+	descriptions := map[string]any{}
+	var locales []string
+	for _, descriptionList := range []any{existing["localeWiseDescription"], scope["localeWiseDescription"]} {
+		items, _ := descriptionList.([]any)
+		for _, item := range items {
+			description, _ := item.(object)
+			locale := str(description, "locale")
+			if _, ok := descriptions[locale]; !ok {
+				locales = append(locales, locale)
+			}
+			descriptions[locale] = description
+		}
+	}
+	merged := make([]any, 0, len(locales))
+	for _, locale := range locales {
+		merged = append(merged, descriptions[locale])
+	}
+	scope["localeWiseDescription"] = merged
+	writeData(w, s.save(collectionScopes, key, merge(existing, scope)))
+}
+
+func (s *Server) getScope(w http.ResponseWriter, r *http.Request) {
+	scope, ok := s.get(collectionScopes, strings.ToLower(r.URL.Query().Get("scopekey")))
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeData(w, scope)
+}
+
+func (s *Server) listScopes(w http.ResponseWriter, _ *http.Request) {
+	writeData(w, s.list(collectionScopes, nil))
+}
+
+func (s *Server) deleteScope(w http.ResponseWriter, r *http.Request) {
+	s.delete(collectionScopes, strings.ToLower(r.PathValue("scopeKey")))
+	writeDeleted(w)
+}
+
+func (s *Server) deleteScopeLocale(w http.ResponseWriter, r *http.Request) {
+	key := strings.ToLower(r.PathValue("scopeKey"))
+	scope, ok := s.get(collectionScopes, key)
+	if !ok {
+		writeDeleted(w)
+		return
+	}
+	items, _ := scope["localeWiseDescription"].([]any)
+	remaining := make([]any, 0, len(items))
+	for _, item := range items {
+		if description, _ := item.(object); str(description, "locale") != r.PathValue("locale") {
+			remaining = append(remaining, item)
+		}
+	}
+	scope = merge(scope, object{"localeWiseDescription": remaining})
+	s.save(collectionScopes, key, scope)
+	writeDeleted(w)
+}
+
+func (s *Server) upsertScopeGroup(w http.ResponseWriter, r *http.Request) {
+	var group object
+	if !decode(w, r, &group) || !required(w, group, "group_name") {
+		return
+	}
+	existing, ok := s.get(collectionScopeGroups, str(group, "group_name"))
+	if !ok {
+		existing = object{"_id": s.newID()}
+	}
+	writeData(w, s.save(collectionScopeGroups, str(group, "group_name"), merge(existing, group)))
+}
+
+func (s *Server) getScopeGroup(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.get(collectionScopeGroups, r.URL.Query().Get("group_name"))
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeData(w, group)
+}
+
+func (s *Server) listScopeGroups(w http.ResponseWriter, _ *http.Request) {
+	writeData(w, s.list(collectionScopeGroups, nil))
+}
+
+func (s *Server) deleteScopeGroup(w http.ResponseWriter, r *http.Request) {
+	s.delete(collectionScopeGroups, r.PathValue("groupName"))
+	writeDeleted(w)
+}
@@ -0,0 +1,124 @@
+package simulator
+
+// seed stores the objects a new cidaas instance comes with and the objects the data sources are tested with.
+func (s *Server) seed() {
+	s.save(collectionTenant, tenantKey, object{
+		"_id":            s.newID(),
+		"tenant_key":     tenantKey,
+		"tenant_name":    "cidaas simulator",
+		"default_locale": "en-US",
+		"company_name":   "Widas ID GmbH",
+	})
+
+	for i, fieldKey := range []string{"email", "given_name", "family_name", "mobile_number", "password"} {
+		dataType := "TEXT"
+		if fieldKey == "email" || fieldKey == "mobile_number" || fieldKey == "password" {
+			dataType = fieldKey
+		}
+		s.save(collectionRegistrationFields, fieldKey, object{
+			"_id":             s.newID(),
+			"fieldKey":        fieldKey,
+			"dataType":        dataType,
+			"baseDataType":    baseDataType(dataType),
+			"fieldType":       fieldTypeSystem,
+			"parent_group_id": "DEFAULT",
+			"internal":        true,
+			"enabled":         true,
+			"order":           i + 1,
+		})
+	}
+
+	for _, role := range []string{"ADMIN", "USER"} {
+		s.save(collectionRoles, role, object{"role": role, "name": role, "description": "The " + role + " role"})
+	}
+
+	for _, scopeKey := range []string{"openid", "profile", "email"} {
+		s.save(collectionScopes, scopeKey, object{
+			"_id":                 s.newID(),
+			"scopeKey":            scopeKey,
+			"securityLevel":       "PUBLIC",
+			"requiredUserConsent": false,
+			"scopeOwner":          "SYSTEM",
+			"localeWiseDescription": []any{object{
+				"locale":      "en-US",
+				"language":    "en",
+				"title":       scopeKey,
+				"description": "The " + scopeKey + " scope",
+			}},
+		})
+	}
+	s.save(collectionScopeGroups, "default", object{"_id": s.newID(), "group_name": "default", "description": "The default scope group"})
+
+	s.save(collectionGroupTypes, "default", object{
+		"_id":          s.newID(),
+		"groupType":    "default",
+		"roleMode":     "allowed_roles",
+		"allowedRoles": []any{"USER"},
+		"description":  "The default group type",
+	})
+
+	consentGroupID := s.newID()
+	s.save(collectionConsentGroups, consentGroupID, object{"_id": consentGroupID, "group_name": "default", "description": "The default consent group"})
+	consentID := s.newID()
+	s.save(collectionConsents, consentID, object{"_id": consentID, "consent_group_id": consentGroupID, "consent_name": "terms", "enabled": true})
+
+	// a custom field, a custom provider and a social provider, as the data sources are tested against a tenant which has them configured
+	s.save(collectionRegistrationFields, "newsletter", object{
+		"_id":             s.newID(),
+		"fieldKey":        "newsletter",
+		"dataType":        "CHECKBOX",
+		"baseDataType":    baseDataType("CHECKBOX"),
+		"fieldType":       "CUSTOM",
+		"parent_group_id": "DEFAULT",
+		"enabled":         true,
+		"order":           6,
+	})
+	s.save(collectionCustomProviders, "sample", object{
+		"_id":                    s.newID(),
+		"provider_name":          "sample",
+		"display_name":           "Sample",
+		"standard_type":          "OPENID_CONNECT",
+		"client_id":              "sample-client",
+		"client_secret":          "sample-secret",
+		"authorization_endpoint": "https://sample.example.com/authorize",
+		"token_endpoint":         "https://sample.example.com/token",
+		"userinfo_endpoint":      "https://sample.example.com/userinfo",
+	})
+	socialProviderID := s.newID()
+	s.save(collectionSocialProviders, socialProviderKey("google", socialProviderID), object{
+		"id":                       socialProviderID,
+		"name":                     "google",
+		"provider_name":            "google",
+		"client_id":                "google-client",
+		"client_secret":            "google-secret",
+		"enabled":                  true,
+		"enabled_for_admin_portal": true,
+		"scopes":                   []any{"profile", "email"},
+	})
+
+	verificationTypes := func(verificationType string) []object {
+		return []object{{
+			"processingType": "GENERAL",
+			"verificationTypes": []object{{
+				"verificationType": verificationType,
+				"usageTypes":       []string{"VERIFICATION_CONFIGURATION", "MULTIFACTOR_AUTHENTICATION", "PASSWORDLESS_AUTHENTICATION"},
+			}},
+		}}
+	}
+	s.save(collectionMasterSettings, "VERIFY_USER", object{
+		"templateKey": "VERIFY_USER",
+		"enabled":     true,
+		"templateTypes": []object{
+			{"templateType": "EMAIL", "processingTypes": verificationTypes("EMAIL")},
+			{"templateType": "SMS", "processingTypes": verificationTypes("SMS")},
+			{"templateType": "IVR", "processingTypes": verificationTypes("IVR")},
+		},
+	})
+	s.save(collectionMasterSettings, "UN_REGISTER_USER_ALERT", object{
+		"templateKey": "UN_REGISTER_USER_ALERT",
+		"enabled":     true,
+		"templateTypes": []object{
+			{"templateType": "EMAIL", "default": object{"processingType": "GENERAL"}},
+		},
+	})
+}
@@ -0,0 +1,228 @@
+// Package simulator provides a stateful in-memory fake of the cidaas services used by the provider.
+// It allows to run the acceptance tests without the credentials of a cidaas instance.
+package simulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// ClientID and ClientSecret are the client credentials accepted by the token endpoint of the simulator.
+	ClientID     = "cidaas-simulator-client"
+	ClientSecret = "cidaas-simulator-secret" //nolint:gosec
+	// AccessToken is the token issued by the simulator, all other endpoints require it as bearer token.
+	AccessToken = "cidaas-simulator-token" //nolint:gosec
+)
+
+// object is a JSON object as it is sent to and stored by the simulator.
+type object = map[string]any
+
+// Server is the simulator of the cidaas services started with httptest.
+// The objects are kept in memory per collection and key, requests are handled one at a time.
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	collections map[string]map[string]object
+	sequence    int
+}
+
+// New starts a simulator with the tenant settings, the system registration fields and the settings
+// of the system templates a new cidaas instance comes with, and a few sample objects for the data sources.
+// Close must be called to stop it.
+func New() *Server {
+	s := &Server{collections: map[string]map[string]object{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /token-srv/token", s.token)
+	s.registerRoles(mux)
+	s.registerScopes(mux)
+	s.registerGroups(mux)
+	s.registerApps(mux)
+	s.registerWebhooks(mux)
+	s.registerTemplates(mux)
+	s.registerRegistrationFields(mux)
+	s.registerConsents(mux)
+	s.registerHostedPages(mux)
+	s.registerProviders(mux)
+	s.registerTenant(mux)
+	s.registerUsers(mux)
+	s.registerPasswordPolicies(mux)
+	s.seed()
+
+	s.Server = httptest.NewServer(s.handle(mux))
+	return s
+}
+
+// handle serializes the requests and rejects requests without the access token of the simulator.
+func (s *Server) handle(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if r.URL.Path != "/token-srv/token" && r.Header.Get("Authorization") != "Bearer "+AccessToken {
+			writeError(w, http.StatusUnauthorized, "invalid or missing access token")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	var payload map[string]string
+	if !decode(w, r, &payload) {
+		return
+	}
+	if payload["grant_type"] != "client_credentials" || payload["client_id"] != ClientID || payload["client_secret"] != ClientSecret {
+		writeError(w, http.StatusUnauthorized, "invalid client credentials")
+		return
+	}
+	writeJSON(w, http.StatusOK, object{
+		"access_token": AccessToken,
+		"token_type":   "Bearer",
+		"expires_in":   86400,
+	})
+}
+
+// newID returns a unique ID in the format of the IDs generated by cidaas.
+func (s *Server) newID() string {
+	s.sequence++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", s.sequence)
+}
+
+func (s *Server) get(collection, key string) (object, bool) {
+	obj, ok := s.collections[collection][key]
+	return obj, ok
+}
+
+// save stores the object under the key and maintains its createdTime and updatedTime.
+func (s *Server) save(collection, key string, obj object) object {
+	if s.collections[collection] == nil {
+		s.collections[collection] = map[string]object{}
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	if existing, ok := s.collections[collection][key]; ok && existing["createdTime"] != nil {
+		obj["createdTime"] = existing["createdTime"]
+	} else {
+		obj["createdTime"] = now
+	}
+	obj["updatedTime"] = now
+	s.collections[collection][key] = obj
+	return obj
+}
+
+// upsert creates the object with a generated _id or updates the object with the _id of the payload.
+func (s *Server) upsert(collection string, obj object) object {
+	existing, ok := s.get(collection, str(obj, "_id"))
+	if !ok {
+		obj["_id"] = s.newID()
+	}
+	return s.save(collection, str(obj, "_id"), merge(existing, obj))
+}
+
+func (s *Server) delete(collection, key string) bool {
+	if _, ok := s.collections[collection][key]; !ok {
+		return false
+	}
+	delete(s.collections[collection], key)
+	return true
+}
+
+// list returns the objects of the collection ordered by key which match the filter, a nil filter matches all.
+func (s *Server) list(collection string, filter func(object) bool) []object {
+	keys := make([]string, 0, len(s.collections[collection]))
+	for key := range s.collections[collection] {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := []object{}
+	for _, key := range keys {
+		obj := s.collections[collection][key]
+		if filter == nil || filter(obj) {
+			result = append(result, obj)
+		}
+	}
+	return result
+}
+
+// merge returns a copy of the existing object with the attributes of the update.
+func merge(existing, update object) object {
+	merged := object{}
+	for k, v := range existing {
+		merged[k] = v
+	}
+	for k, v := range update {
+		merged[k] = v
+	}
+	return merged
+}
+
+// str returns the string attribute of the object or an empty string.
+func str(obj object, key string) string {
+	value, _ := obj[key].(string)
+	return value
+}
+
+// decode reads the JSON body of the request and writes a bad request response if it is invalid.
+func decode(w http.ResponseWriter, r *http.Request, target any) bool {
+	if err := json.NewDecoder(r.Body).Decode(target); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %s", err.Error()))
+		return false
+	}
+	return true
+}
+
+// required writes a bad request response if one of the attributes of the object is empty.
+func required(w http.ResponseWriter, obj object, keys ...string) bool {
+	for _, key := range keys {
+		if strings.TrimSpace(str(obj, key)) == "" {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("%s is required", key))
+			return false
+		}
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeData writes the response envelope of the cidaas services.
+func writeData(w http.ResponseWriter, data any) {
+	writeJSON(w, http.StatusOK, object{
+		"success": true,
+		"status":  http.StatusOK,
+		"data":    data,
+	})
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, object{
+		"success": false,
+		"status":  status,
+		"error": object{
+			"code":  status,
+			"error": message,
+		},
+	})
+}
+
+// writeNotFound responds like cidaas does for objects which do not exist, with status 204 and no body.
+func writeNotFound(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeDeleted responds to a delete request. Like cidaas, deleting an object which does not exist succeeds.
+func writeDeleted(w http.ResponseWriter) {
+	writeData(w, true)
+}
@@ -0,0 +1,242 @@
+package simulator_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/test/simulator"
+)
+
+func newClient(t *testing.T) *cidaas.Client {
+	t.Helper()
+	server := simulator.New()
+	t.Cleanup(server.Close)
+
+	client, err := cidaas.NewClient(context.Background(), cidaas.ClientConfig{
+		BaseURL:      server.URL,
+		ClientID:     simulator.ClientID,
+		ClientSecret: simulator.ClientSecret,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return client
+}
+
+func TestSimulator_Token(t *testing.T) {
+	server := simulator.New()
+	defer server.Close()
+
+	_, err := cidaas.NewClient(context.Background(), cidaas.ClientConfig{
+		BaseURL:      server.URL,
+		ClientID:     simulator.ClientID,
+		ClientSecret: "invalid",
+	})
+	if err == nil {
+		t.Fatal("Expected an error for invalid client credentials")
+	}
+
+	res, err := http.Get(server.URL + "/roles-srv/role?role=admin")
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Expected status 401 without access token, got %d", res.StatusCode)
+	}
+}
+
+func TestSimulator_Roles(t *testing.T) {
+	ctx := context.Background()
+	client := newClient(t)
+
+	if _, err := client.Roles.UpsertRole(ctx, cidaas.RoleModel{Role: "admin", Name: "Admin"}); err != nil {
+		t.Fatalf("UpsertRole failed: %v", err)
+	}
+	if _, err := client.Roles.UpsertRole(ctx, cidaas.RoleModel{Role: "admin", Description: "administrators"}); err != nil {
+		t.Fatalf("UpsertRole failed: %v", err)
+	}
+	role, err := client.Roles.GetRole(ctx, "admin")
+	if err != nil {
+		t.Fatalf("GetRole failed: %v", err)
+	}
+	if role.Data.Name != "Admin" || role.Data.Description != "administrators" {
+		t.Errorf("Expected the role to be updated, got %+v", role.Data)
+	}
+
+	user, err := client.Users.Create(ctx, cidaas.UserModel{Email: "john@example.com", Password: "secret"})
+	if err != nil {
+		t.Fatalf("Create user failed: %v", err)
+	}
+	if user.Data.Sub == "" || user.Data.Password != "" {
+		t.Errorf("Expected a generated sub and no password, got %+v", user.Data)
+	}
+	if _, err := client.Roles.AssignUser(ctx, cidaas.UserRoleModel{Role: "admin", Sub: user.Data.Sub}); err != nil {
+		t.Fatalf("AssignUser failed: %v", err)
+	}
+	if _, err := client.Roles.AssignUser(ctx, cidaas.UserRoleModel{Role: "unknown", Sub: user.Data.Sub}); err == nil {
+		t.Error("Expected an error when assigning a role which does not exist")
+	}
+	members, err := client.Roles.GetMembers(ctx, "admin")
+	if err != nil {
+		t.Fatalf("GetMembers failed: %v", err)
+	}
+	if len(members) != 1 || members[0] != user.Data.Sub {
+		t.Errorf("Expected the user to be a member of the role, got %v", members)
+	}
+
+	if err := client.Roles.DeleteRole(ctx, "admin"); err != nil {
+		t.Fatalf("DeleteRole failed: %v", err)
+	}
+	if _, err := client.Roles.GetRole(ctx, "admin"); err == nil {
+		t.Error("Expected an error when reading a deleted role")
+	}
+	members, err = client.Roles.GetMembers(ctx, "admin")
+	if err != nil {
+		t.Fatalf("GetMembers failed: %v", err)
+	}
+	if len(members) != 0 {
+		t.Errorf("Expected the members to be removed with the role, got %v", members)
+	}
+}
+
+func TestSimulator_ScopeLocales(t *testing.T) {
+	ctx := context.Background()
+	client := newClient(t)
+
+	for _, locale := range []string{"en-US", "de-DE"} {
+		_, err := client.Scopes.Upsert(ctx, cidaas.ScopeModel{
+			ScopeKey:              "Profile",
+			LocaleWiseDescription: []cidaas.ScopeLocalDescription{{Locale: locale, Title: "title " + locale}},
+		})
+		if err != nil {
+			t.Fatalf("Upsert failed: %v", err)
+		}
+	}
+	scope, err := client.Scopes.Get(ctx, "profile")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if len(scope.Data.LocaleWiseDescription) != 2 {
+		t.Fatalf("Expected the descriptions of both locales, got %+v", scope.Data.LocaleWiseDescription)
+	}
+
+	if err := client.Scopes.DeleteLocale(ctx, "profile", "en-US"); err != nil {
+		t.Fatalf("DeleteLocale failed: %v", err)
+	}
+	scope, err = client.Scopes.Get(ctx, "profile")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if len(scope.Data.LocaleWiseDescription) != 1 || scope.Data.LocaleWiseDescription[0].Locale != "de-DE" {
+		t.Errorf("Expected only the locale de-DE, got %+v", scope.Data.LocaleWiseDescription)
+	}
+}
+
+func TestSimulator_Apps(t *testing.T) {
+	ctx := context.Background()
+	client := newClient(t)
+
+	app, err := client.Apps.Create(ctx, cidaas.AppModel{ClientName: "sample app"})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if app.Data.ClientID == "" || app.Data.ClientSecret == "" {
+		t.Fatalf("Expected a generated client_id and client_secret, got %+v", app.Data)
+	}
+
+	update := app.Data
+	update.ClientName = "renamed app"
+	update.ClientSecret = ""
+	if _, err := client.Apps.Update(ctx, update); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	read, err := client.Apps.Get(ctx, app.Data.ClientID)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if read.Data.ClientName != "renamed app" || read.Data.ClientSecret != app.Data.ClientSecret {
+		t.Errorf("Expected the name to be updated and the secret to be kept, got %+v", read.Data)
+	}
+
+	apps, err := client.Apps.GetAll(ctx)
+	if err != nil {
+		t.Fatalf("GetAll failed: %v", err)
+	}
+	if len(apps) != 1 {
+		t.Errorf("Expected 1 app, got %d", len(apps))
+	}
+}
+
+func TestSimulator_UserGroups(t *testing.T) {
+	ctx := context.Background()
+	client := newClient(t)
+
+	if _, err := client.UserGroup.Create(ctx, cidaas.UserGroupData{GroupID: "team", GroupName: "Team", GroupType: "department"}); err == nil {
+		t.Fatal("Expected an error for a group type which does not exist")
+	}
+	if _, err := client.GroupType.Create(ctx, cidaas.GroupTypeData{GroupType: "department", RoleMode: "any_roles"}); err != nil {
+		t.Fatalf("Create group type failed: %v", err)
+	}
+	for _, group := range []cidaas.UserGroupData{
+		{GroupID: "team", GroupName: "Team", GroupType: "department"},
+		{GroupID: "sub-team", GroupName: "Sub Team", GroupType: "department", ParentID: "team"},
+	} {
+		if _, err := client.UserGroup.Create(ctx, group); err != nil {
+			t.Fatalf("Create user group failed: %v", err)
+		}
+	}
+
+	groups, err := client.UserGroup.GetAll(ctx)
+	if err != nil {
+		t.Fatalf("GetAll failed: %v", err)
+	}
+	if len(groups) != 2 || groups[0].GroupID != "team" || groups[1].GroupID != "sub-team" {
+		t.Errorf("Expected the groups team and sub-team, got %+v", groups)
+	}
+}
+
+func TestSimulator_ConsentVersionLocales(t *testing.T) {
+	ctx := context.Background()
+	client := newClient(t)
+
+	group, err := client.ConsentGroup.Upsert(ctx, cidaas.ConsentGroupConfig{GroupName: "marketing"})
+	if err != nil {
+		t.Fatalf("Upsert consent group failed: %v", err)
+	}
+	consent, err := client.Consent.Upsert(ctx, cidaas.ConsentModel{ConsentGroupID: group.Data.ID, ConsentName: "newsletter", Enabled: true})
+	if err != nil {
+		t.Fatalf("Upsert consent failed: %v", err)
+	}
+	version, err := client.ConsentVersion.Upsert(ctx, cidaas.ConsentVersionModel{
+		ConsentID:     consent.Data.ID,
+		Version:       1,
+		ConsentType:   "SCOPES",
+		Scopes:        []string{"profile"},
+		ConsentLocale: cidaas.ConsentLocale{Locale: "en", Content: "content"},
+	})
+	if err != nil {
+		t.Fatalf("Upsert consent version failed: %v", err)
+	}
+
+	locale, err := client.ConsentVersion.GetLocal(ctx, version.Data.ID, "en")
+	if err != nil {
+		t.Fatalf("GetLocal failed: %v", err)
+	}
+	if locale.Data.Content != "content" || len(locale.Data.Scopes) != 1 {
+		t.Errorf("Expected the locale of the version with its scopes, got %+v", locale.Data)
+	}
+
+	if err := client.ConsentVersion.Delete(ctx, version.Data.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	locale, err = client.ConsentVersion.GetLocal(ctx, version.Data.ID, "en")
+	if err != nil {
+		t.Fatalf("GetLocal failed: %v", err)
+	}
+	if locale.Status != http.StatusNoContent {
+		t.Errorf("Expected status 204 for the locale of a deleted version, got %d", locale.Status)
+	}
+}
@@ -0,0 +1,183 @@
+package simulator
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	collectionSystemTemplates = "systemtemplates"
+	collectionCustomTemplates = "customtemplates"
+	collectionTemplateGroups  = "templategroups"
+	collectionMasterSettings  = "mastersettings"
+)
+
+func (s *Server) registerTemplates(mux *http.ServeMux) {
+	mux.HandleFunc("POST /templates-srv/template", s.upsertSystemTemplate)
+	mux.HandleFunc("POST /templates-srv/template/find", s.findSystemTemplate)
+	mux.HandleFunc("POST /templates-srv/template/custom", s.upsertCustomTemplate)
+	mux.HandleFunc("POST /templates-srv/template/custom/find", s.findCustomTemplate)
+	mux.HandleFunc("POST /templates-srv/template/custom/list", s.listCustomTemplates)
+	mux.HandleFunc("DELETE /templates-srv/template/custom/{templateKey}/{templateType}", s.deleteCustomTemplate)
+	mux.HandleFunc("DELETE /templates-srv/template/custom/{templateKey}/{templateType}/{locale}", s.deleteCustomTemplateLocale)
+	mux.HandleFunc("GET /templates-srv/master/settings/{groupID}", s.getMasterSettings)
+
+	mux.HandleFunc("POST /templates-srv/groups", s.createTemplateGroup)
+	mux.HandleFunc("GET /templates-srv/groups/{groupID}", s.getTemplateGroup)
+	mux.HandleFunc("PUT /templates-srv/groups/{groupID}", s.updateTemplateGroup)
+	mux.HandleFunc("DELETE /templates-srv/groups/{groupID}", s.deleteTemplateGroup)
+}
+
+// systemTemplateKey identifies a system template by its group and all the types it is configured for.
+func systemTemplateKey(template object) string {
+	return strings.Join([]string{
+		str(template, "group_id"),
+		str(template, "templateKey"),
+		str(template, "templateType"),
+		strings.ToLower(str(template, "locale")),
+		str(template, "processingType"),
+		str(template, "verificationType"),
+		str(template, "usageType"),
+	}, "|")
+}
+
+func customTemplateKey(templateKey, templateType, locale string) string {
+	return strings.ToUpper(templateKey) + "|" + strings.ToUpper(templateType) + "|" + strings.ToLower(locale)
+}
+
+// upsertSystemTemplate rejects templates which are not part of the master settings.
+func (s *Server) upsertSystemTemplate(w http.ResponseWriter, r *http.Request) {
+	var template object
+	if !decode(w, r, &template) || !required(w, template, "group_id", "templateKey", "templateType", "locale") {
+		return
+	}
+	if _, ok := s.get(collectionMasterSettings, str(template, "templateKey")); !ok {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("%s is not a system template", str(template, "templateKey")))
+		return
+	}
+	existing, ok := s.get(collectionSystemTemplates, systemTemplateKey(template))
+	if !ok {
+		existing = object{"_id": s.newID()}
+	}
+	writeData(w, s.save(collectionSystemTemplates, systemTemplateKey(template), merge(existing, template)))
+}
+
+func (s *Server) findSystemTemplate(w http.ResponseWriter, r *http.Request) {
+	var template object
+	if !decode(w, r, &template) {
+		return
+	}
+	found, ok := s.get(collectionSystemTemplates, systemTemplateKey(template))
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeData(w, found)
+}
+
+// upsertCustomTemplate assigns the usage type GENERAL to a new template if none is given.
+func (s *Server) upsertCustomTemplate(w http.ResponseWriter, r *http.Request) {
+	var template object
+	if !decode(w, r, &template) || !required(w, template, "templateKey", "templateType", "locale") {
+		return
+	}
+	key := customTemplateKey(str(template, "templateKey"), str(template, "templateType"), str(template, "locale"))
+	existing, ok := s.get(collectionCustomTemplates, key)
+	if !ok {
+		existing = object{"_id": s.newID(), "usageType": "GENERAL"}
+	}
+	writeData(w, s.save(collectionCustomTemplates, key, merge(existing, template)))
+}
+
+func (s *Server) findCustomTemplate(w http.ResponseWriter, r *http.Request) {
+	var template object
+	if !decode(w, r, &template) {
+		return
+	}
+	found, ok := s.get(collectionCustomTemplates, customTemplateKey(str(template, "templateKey"), str(template, "templateType"), str(template, "locale")))
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeData(w, found)
+}
+
+// listCustomTemplates filters by templateKey and templateType if they are given and responds with status 204 if nothing matches.
+func (s *Server) listCustomTemplates(w http.ResponseWriter, r *http.Request) {
+	var filter object
+	if !decode(w, r, &filter) {
+		return
+	}
+	templates := s.list(collectionCustomTemplates, func(obj object) bool {
+		return (str(filter, "templateKey") == "" || strings.EqualFold(str(obj, "templateKey"), str(filter, "templateKey"))) &&
+			(str(filter, "templateType") == "" || strings.EqualFold(str(obj, "templateType"), str(filter, "templateType")))
+	})
+	if len(templates) == 0 {
+		writeNotFound(w)
+		return
+	}
+	writeData(w, templates)
+}
+
+// deleteCustomTemplate removes the templates of all locales.
+func (s *Server) deleteCustomTemplate(w http.ResponseWriter, r *http.Request) {
+	templateKey, templateType := r.PathValue("templateKey"), r.PathValue("templateType")
+	for _, template := range s.list(collectionCustomTemplates, func(obj object) bool {
+		return strings.EqualFold(str(obj, "templateKey"), templateKey) && strings.EqualFold(str(obj, "templateType"), templateType)
+	}) {
+		s.delete(collectionCustomTemplates, customTemplateKey(templateKey, templateType, str(template, "locale")))
+	}
+	writeDeleted(w)
+}
+
+func (s *Server) deleteCustomTemplateLocale(w http.ResponseWriter, r *http.Request) {
+	s.delete(collectionCustomTemplates, customTemplateKey(r.PathValue("templateKey"), r.PathValue("templateType"), r.PathValue("locale")))
+	writeDeleted(w)
+}
+
+// getMasterSettings returns the same system templates for every group.
+func (s *Server) getMasterSettings(w http.ResponseWriter, _ *http.Request) {
+	writeData(w, s.list(collectionMasterSettings, nil))
+}
+
+func (s *Server) createTemplateGroup(w http.ResponseWriter, r *http.Request) {
+	var group object
+	if !decode(w, r, &group) || !required(w, group, "group_id") {
+		return
+	}
+	if _, ok := s.get(collectionTemplateGroups, str(group, "group_id")); ok {
+		writeError(w, http.StatusConflict, fmt.Sprintf("template group %s already exists", str(group, "group_id")))
+		return
+	}
+	group["id"] = s.newID()
+	writeData(w, s.save(collectionTemplateGroups, str(group, "group_id"), group))
+}
+
+func (s *Server) getTemplateGroup(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.get(collectionTemplateGroups, r.PathValue("groupID"))
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeData(w, group)
+}
+
+func (s *Server) updateTemplateGroup(w http.ResponseWriter, r *http.Request) {
+	var group object
+	if !decode(w, r, &group) {
+		return
+	}
+	existing, ok := s.get(collectionTemplateGroups, r.PathValue("groupID"))
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("template group %s does not exist", r.PathValue("groupID")))
+		return
+	}
+	group["group_id"] = r.PathValue("groupID")
+	writeData(w, s.save(collectionTemplateGroups, r.PathValue("groupID"), merge(existing, group)))
+}
+
+func (s *Server) deleteTemplateGroup(w http.ResponseWriter, r *http.Request) {
+	s.delete(collectionTemplateGroups, r.PathValue("groupID"))
+	writeDeleted(w)
+}
@@ -0,0 +1,29 @@
+package simulator
+
+import "net/http"
+
+const (
+	collectionTenant = "tenant"
+	tenantKey        = "cidaas-simulator"
+)
+
+func (s *Server) registerTenant(mux *http.ServeMux) {
+	mux.HandleFunc("GET /tenant-srv/tenantinfo", s.getTenantInfo)
+	mux.HandleFunc("PUT /tenant-srv/tenantinfo", s.updateTenantInfo)
+}
+
+func (s *Server) getTenantInfo(w http.ResponseWriter, _ *http.Request) {
+	tenant, _ := s.get(collectionTenant, tenantKey)
+	writeData(w, tenant)
+}
+
+// updateTenantInfo updates the tenant, the tenant key can not be changed.
+func (s *Server) updateTenantInfo(w http.ResponseWriter, r *http.Request) {
+	var tenant object
+	if !decode(w, r, &tenant) {
+		return
+	}
+	existing, _ := s.get(collectionTenant, tenantKey)
+	tenant["tenant_key"] = existing["tenant_key"]
+	writeData(w, s.save(collectionTenant, tenantKey, merge(existing, tenant)))
+}
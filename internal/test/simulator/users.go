@@ -0,0 +1,74 @@
+package simulator
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const collectionUsers = "users"
+
+func (s *Server) registerUsers(mux *http.ServeMux) {
+	mux.HandleFunc("POST /users-srv/user", s.createUser)
+	mux.HandleFunc("GET /users-srv/user/{sub}", s.getUser)
+	mux.HandleFunc("PUT /users-srv/user/{sub}", s.updateUser)
+	mux.HandleFunc("DELETE /users-srv/user/{sub}", s.deleteUser)
+}
+
+// createUser generates the sub of the user. Like cidaas, the password is never returned.
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request) {
+	var user object
+	if !decode(w, r, &user) || !required(w, user, "email") {
+		return
+	}
+	for _, existing := range s.list(collectionUsers, nil) {
+		if str(existing, "email") == str(user, "email") {
+			writeError(w, http.StatusConflict, fmt.Sprintf("user with email %s already exists", str(user, "email")))
+			return
+		}
+	}
+	delete(user, "password")
+	delete(user, "password_echo")
+	user["sub"] = s.newID()
+	if str(user, "user_status") == "" {
+		user["user_status"] = "VERIFIED"
+	}
+	writeData(w, s.save(collectionUsers, str(user, "sub"), user))
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	user, ok := s.get(collectionUsers, r.PathValue("sub"))
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeData(w, user)
+}
+
+func (s *Server) updateUser(w http.ResponseWriter, r *http.Request) {
+	var user object
+	if !decode(w, r, &user) {
+		return
+	}
+	existing, ok := s.get(collectionUsers, r.PathValue("sub"))
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("user %s does not exist", r.PathValue("sub")))
+		return
+	}
+	delete(user, "password")
+	delete(user, "password_echo")
+	user["sub"] = r.PathValue("sub")
+	writeData(w, s.save(collectionUsers, r.PathValue("sub"), merge(existing, user)))
+}
+
+// deleteUser removes the user together with its roles and group memberships.
+func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
+	sub := r.PathValue("sub")
+	s.delete(collectionUsers, sub)
+	for _, userRole := range s.list(collectionUserRoles, func(obj object) bool { return str(obj, "sub") == sub }) {
+		s.delete(collectionUserRoles, userRoleKey(str(userRole, "role"), sub))
+	}
+	for _, member := range s.list(collectionGroupMembers, func(obj object) bool { return str(obj, "sub") == sub }) {
+		s.delete(collectionGroupMembers, groupMemberKey(str(member, "groupId"), sub))
+	}
+	writeDeleted(w)
+}
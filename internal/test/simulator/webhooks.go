@@ -0,0 +1,39 @@
+package simulator
+
+import "net/http"
+
+const collectionWebhooks = "webhooks"
+
+func (s *Server) registerWebhooks(mux *http.ServeMux) {
+	mux.HandleFunc("POST /webhook-srv/webhook", s.upsertWebhook)
+	mux.HandleFunc("GET /webhook-srv/webhook", s.getWebhook)
+	mux.HandleFunc("GET /webhook-srv/webhook/list", s.listWebhooks)
+	mux.HandleFunc("DELETE /webhook-srv/webhook/{id}", s.deleteWebhook)
+}
+
+// upsertWebhook creates a webhook or updates the webhook with the _id of the payload.
+func (s *Server) upsertWebhook(w http.ResponseWriter, r *http.Request) {
+	var webhook object
+	if !decode(w, r, &webhook) || !required(w, webhook, "auth_type", "url") {
+		return
+	}
+	writeData(w, s.upsert(collectionWebhooks, webhook))
+}
+
+func (s *Server) getWebhook(w http.ResponseWriter, r *http.Request) {
+	webhook, ok := s.get(collectionWebhooks, r.URL.Query().Get("id"))
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeData(w, webhook)
+}
+
+func (s *Server) listWebhooks(w http.ResponseWriter, _ *http.Request) {
+	writeData(w, s.list(collectionWebhooks, nil))
+}
+
+func (s *Server) deleteWebhook(w http.ResponseWriter, r *http.Request) {
+	s.delete(collectionWebhooks, r.PathValue("id"))
+	writeDeleted(w)
+}
@@ -32,9 +32,10 @@ func (v UniqueIdentifier) PlanModifyString(_ context.Context, req planmodifier.S
 		return
 	}
 
-	if !req.ConfigValue.Equal(req.StateValue) {
+	// the plan value is compared as it contains the default of an attribute which is not configured
+	if !req.PlanValue.Equal(req.StateValue) {
 		resp.Diagnostics.AddError("Unexpected Resource Configuration",
-			fmt.Sprintf("Attribute '%s' can't be modified. Existing value %s, got %s", req.Path.String(), req.StateValue.ValueString(), req.ConfigValue.ValueString()))
+			fmt.Sprintf("Attribute '%s' can't be modified. Existing value %s, got %s", req.Path.String(), req.StateValue.ValueString(), req.PlanValue.ValueString()))
 	}
 }
 
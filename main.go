@@ -3,9 +3,14 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log"
+	"os"
+	"sort"
 
+	"github.com/Cidaas/terraform-provider-cidaas/helpers/cidaas"
 	provider "github.com/Cidaas/terraform-provider-cidaas/internal"
+	"github.com/Cidaas/terraform-provider-cidaas/internal/export"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
 
@@ -20,6 +25,13 @@ import (
 //
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs generate -provider-name cidaas
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := runExport(os.Args[2:]); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -40,3 +52,52 @@ func main() {
 		log.Fatal(err.Error())
 	}
 }
+
+// runExport generates the configuration and import blocks of an existing tenant, e.g.
+//
+//	terraform-provider-cidaas export --base-url https://cidaas.example.com --out ./generated
+//
+// The client credentials are read from the same environment variables as the provider.
+func runExport(args []string) error {
+	var baseURL, outDir string
+
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	flags.StringVar(&baseURL, "base-url", "", "the base url of the cidaas instance")
+	flags.StringVar(&outDir, "out", "./generated", "the directory the generated .tf files are written to")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if baseURL == "" {
+		return fmt.Errorf("the flag --base-url is required")
+	}
+
+	clientID := os.Getenv("TERRAFORM_PROVIDER_CIDAAS_CLIENT_ID")
+	clientSecret := os.Getenv("TERRAFORM_PROVIDER_CIDAAS_CLIENT_SECRET")
+	if clientID == "" || clientSecret == "" {
+		return fmt.Errorf("env variable TERRAFORM_PROVIDER_CIDAAS_CLIENT_ID or TERRAFORM_PROVIDER_CIDAAS_CLIENT_SECRET missing")
+	}
+
+	ctx := context.Background()
+	client, err := cidaas.NewClient(ctx, cidaas.ClientConfig{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		BaseURL:      baseURL,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create cidaas client %w", err)
+	}
+
+	result, err := export.Run(ctx, client, export.Config{BaseURL: baseURL, OutDir: outDir})
+	if result != nil {
+		types := make([]string, 0, len(result.Resources))
+		for resourceType := range result.Resources {
+			types = append(types, resourceType)
+		}
+		sort.Strings(types)
+		for _, resourceType := range types {
+			fmt.Printf("exported %d %s\n", result.Resources[resourceType], resourceType)
+		}
+		fmt.Printf("wrote %d files to %s\n", len(result.Files), outDir)
+	}
+	return err
+}